@@ -2,14 +2,20 @@ package configs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type ServiceConfig struct {
-	ServerCfg   ServerConfig
-	DatabaseCfg DatabaseConfig
-	SlavesCfg   SlavesConfig
+	ServerCfg    ServerConfig
+	DatabaseCfg  DatabaseConfig
+	SlavesCfg    SlavesConfig
+	CurrencyCfg  CurrencyConfig
+	SecretsCfg   SecretsConfig
+	OutboxCfg    OutboxConfig
+	RecurringCfg RecurringConfig
+	StorageCfg   StorageConfig
 }
 
 type ServerConfig struct {
@@ -33,6 +39,41 @@ type SlavesConfig struct {
 	NotificationUrl string `env:"NOTIFICATION_URL" env-required:"true"`
 }
 
+type CurrencyConfig struct {
+	RatesFile string `env:"RATES_FILE" env-default:""`
+}
+
+type SecretsConfig struct {
+	// base64 encoded 32-byte AES key for broker tokens; without it linking a
+	// broker fails
+	BrokerTokenKey string `env:"BROKER_TOKEN_KEY" env-default:""`
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" env-default:"5s"`
+	MaxAttempts  int           `env:"OUTBOX_MAX_ATTEMPTS" env-default:"8"`
+	BatchSize    int           `env:"OUTBOX_BATCH_SIZE" env-default:"50"`
+}
+
+type RecurringConfig struct {
+	PollInterval time.Duration `env:"RECURRING_POLL_INTERVAL" env-default:"1m"`
+}
+
+type StorageConfig struct {
+	// local or s3
+	Backend  string `env:"STORAGE_BACKEND" env-default:"local"`
+	LocalDir string `env:"STORAGE_LOCAL_DIR" env-default:"./data/attachments"`
+
+	S3Endpoint  string `env:"S3_ENDPOINT" env-default:""`
+	S3Region    string `env:"S3_REGION" env-default:""`
+	S3Bucket    string `env:"S3_BUCKET" env-default:""`
+	S3AccessKey string `env:"S3_ACCESS_KEY" env-default:""`
+	S3SecretKey string `env:"S3_SECRET_KEY" env-default:""`
+	S3UseSSL    bool   `env:"S3_USE_SSL" env-default:"true"`
+
+	MaxAttachmentSize int64 `env:"ATTACHMENT_MAX_SIZE" env-default:"10485760"`
+}
+
 func New() (*ServiceConfig, error) {
 	var cfg ServiceConfig
 
@@ -130,6 +130,8 @@ const (
 	TimePeriod_TIME_PERIOD_MONTH       TimePeriod = 1
 	TimePeriod_TIME_PERIOD_QUARTER     TimePeriod = 2
 	TimePeriod_TIME_PERIOD_YEAR        TimePeriod = 3
+	TimePeriod_TIME_PERIOD_WEEK        TimePeriod = 4
+	TimePeriod_TIME_PERIOD_DAY         TimePeriod = 5
 )
 
 // Enum value maps for TimePeriod.
@@ -139,12 +141,16 @@ var (
 		1: "TIME_PERIOD_MONTH",
 		2: "TIME_PERIOD_QUARTER",
 		3: "TIME_PERIOD_YEAR",
+		4: "TIME_PERIOD_WEEK",
+		5: "TIME_PERIOD_DAY",
 	}
 	TimePeriod_value = map[string]int32{
 		"TIME_PERIOD_UNSPECIFIED": 0,
 		"TIME_PERIOD_MONTH":       1,
 		"TIME_PERIOD_QUARTER":     2,
 		"TIME_PERIOD_YEAR":        3,
+		"TIME_PERIOD_WEEK":        4,
+		"TIME_PERIOD_DAY":         5,
 	}
 )
 
@@ -308,13 +314,15 @@ const file_common_common_proto_rawDesc = "" +
 	"\vAccountType\x12\x1c\n" +
 	"\x18ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ACCOUNT_TYPE_REGULAR\x10\x01\x12\x1b\n" +
-	"\x17ACCOUNT_TYPE_INVESTMENT\x10\x02*o\n" +
+	"\x17ACCOUNT_TYPE_INVESTMENT\x10\x02*\x9a\x01\n" +
 	"\n" +
 	"TimePeriod\x12\x1b\n" +
 	"\x17TIME_PERIOD_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11TIME_PERIOD_MONTH\x10\x01\x12\x17\n" +
 	"\x13TIME_PERIOD_QUARTER\x10\x02\x12\x14\n" +
-	"\x10TIME_PERIOD_YEAR\x10\x03B\x7f\n" +
+	"\x10TIME_PERIOD_YEAR\x10\x03\x12\x14\n" +
+	"\x10TIME_PERIOD_WEEK\x10\x04\x12\x13\n" +
+	"\x0fTIME_PERIOD_DAY\x10\x05B\x7f\n" +
 	"\n" +
 	"com.commonB\vCommonProtoP\x01Z,backend-master/internal/api-gen/proto/common\xa2\x02\x03CXX\xaa\x02\x06Common\xca\x02\x06Common\xe2\x02\x12Common\\GPBMetadata\xea\x02\x06Commonb\x06proto3"
 
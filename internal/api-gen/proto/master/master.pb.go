@@ -9,6 +9,7 @@ package master
 import (
 	analyzer "backend-master/internal/api-gen/proto/analyzer"
 	common "backend-master/internal/api-gen/proto/common"
+	market "backend-master/internal/api-gen/proto/market"
 	wallet "backend-master/internal/api-gen/proto/wallet"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -26,6 +27,269 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GoalAccountState int32
+
+const (
+	GoalAccountState_GOAL_ACCOUNT_STATE_UNSPECIFIED GoalAccountState = 0
+	GoalAccountState_GOAL_ACCOUNT_STATE_ACTIVE      GoalAccountState = 1
+	GoalAccountState_GOAL_ACCOUNT_STATE_ARCHIVED    GoalAccountState = 2
+	GoalAccountState_GOAL_ACCOUNT_STATE_DELETED     GoalAccountState = 3
+)
+
+// Enum value maps for GoalAccountState.
+var (
+	GoalAccountState_name = map[int32]string{
+		0: "GOAL_ACCOUNT_STATE_UNSPECIFIED",
+		1: "GOAL_ACCOUNT_STATE_ACTIVE",
+		2: "GOAL_ACCOUNT_STATE_ARCHIVED",
+		3: "GOAL_ACCOUNT_STATE_DELETED",
+	}
+	GoalAccountState_value = map[string]int32{
+		"GOAL_ACCOUNT_STATE_UNSPECIFIED": 0,
+		"GOAL_ACCOUNT_STATE_ACTIVE":      1,
+		"GOAL_ACCOUNT_STATE_ARCHIVED":    2,
+		"GOAL_ACCOUNT_STATE_DELETED":     3,
+	}
+)
+
+func (x GoalAccountState) Enum() *GoalAccountState {
+	p := new(GoalAccountState)
+	*p = x
+	return p
+}
+
+func (x GoalAccountState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoalAccountState) Descriptor() protoreflect.EnumDescriptor {
+	return file_master_master_proto_enumTypes[0].Descriptor()
+}
+
+func (GoalAccountState) Type() protoreflect.EnumType {
+	return &file_master_master_proto_enumTypes[0]
+}
+
+func (x GoalAccountState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoalAccountState.Descriptor instead.
+func (GoalAccountState) EnumDescriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportSignConvention int32
+
+const (
+	ImportSignConvention_IMPORT_SIGN_CONVENTION_UNSPECIFIED      ImportSignConvention = 0
+	ImportSignConvention_IMPORT_SIGN_CONVENTION_NEGATIVE_EXPENSE ImportSignConvention = 1
+	ImportSignConvention_IMPORT_SIGN_CONVENTION_POSITIVE_EXPENSE ImportSignConvention = 2
+	ImportSignConvention_IMPORT_SIGN_CONVENTION_DEBIT_CREDIT     ImportSignConvention = 3
+)
+
+// Enum value maps for ImportSignConvention.
+var (
+	ImportSignConvention_name = map[int32]string{
+		0: "IMPORT_SIGN_CONVENTION_UNSPECIFIED",
+		1: "IMPORT_SIGN_CONVENTION_NEGATIVE_EXPENSE",
+		2: "IMPORT_SIGN_CONVENTION_POSITIVE_EXPENSE",
+		3: "IMPORT_SIGN_CONVENTION_DEBIT_CREDIT",
+	}
+	ImportSignConvention_value = map[string]int32{
+		"IMPORT_SIGN_CONVENTION_UNSPECIFIED":      0,
+		"IMPORT_SIGN_CONVENTION_NEGATIVE_EXPENSE": 1,
+		"IMPORT_SIGN_CONVENTION_POSITIVE_EXPENSE": 2,
+		"IMPORT_SIGN_CONVENTION_DEBIT_CREDIT":     3,
+	}
+)
+
+func (x ImportSignConvention) Enum() *ImportSignConvention {
+	p := new(ImportSignConvention)
+	*p = x
+	return p
+}
+
+func (x ImportSignConvention) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportSignConvention) Descriptor() protoreflect.EnumDescriptor {
+	return file_master_master_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportSignConvention) Type() protoreflect.EnumType {
+	return &file_master_master_proto_enumTypes[1]
+}
+
+func (x ImportSignConvention) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportSignConvention.Descriptor instead.
+func (ImportSignConvention) EnumDescriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{1}
+}
+
+type RecurrenceFrequency int32
+
+const (
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED RecurrenceFrequency = 0
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY       RecurrenceFrequency = 1
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY      RecurrenceFrequency = 2
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY     RecurrenceFrequency = 3
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY      RecurrenceFrequency = 4
+)
+
+// Enum value maps for RecurrenceFrequency.
+var (
+	RecurrenceFrequency_name = map[int32]string{
+		0: "RECURRENCE_FREQUENCY_UNSPECIFIED",
+		1: "RECURRENCE_FREQUENCY_DAILY",
+		2: "RECURRENCE_FREQUENCY_WEEKLY",
+		3: "RECURRENCE_FREQUENCY_MONTHLY",
+		4: "RECURRENCE_FREQUENCY_YEARLY",
+	}
+	RecurrenceFrequency_value = map[string]int32{
+		"RECURRENCE_FREQUENCY_UNSPECIFIED": 0,
+		"RECURRENCE_FREQUENCY_DAILY":       1,
+		"RECURRENCE_FREQUENCY_WEEKLY":      2,
+		"RECURRENCE_FREQUENCY_MONTHLY":     3,
+		"RECURRENCE_FREQUENCY_YEARLY":      4,
+	}
+)
+
+func (x RecurrenceFrequency) Enum() *RecurrenceFrequency {
+	p := new(RecurrenceFrequency)
+	*p = x
+	return p
+}
+
+func (x RecurrenceFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_master_master_proto_enumTypes[2].Descriptor()
+}
+
+func (RecurrenceFrequency) Type() protoreflect.EnumType {
+	return &file_master_master_proto_enumTypes[2]
+}
+
+func (x RecurrenceFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceFrequency.Descriptor instead.
+func (RecurrenceFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{2}
+}
+
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	ImportFormat_IMPORT_FORMAT_CSV         ImportFormat = 1
+	ImportFormat_IMPORT_FORMAT_OFX         ImportFormat = 2
+	ImportFormat_IMPORT_FORMAT_QIF         ImportFormat = 3
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_OFX",
+		3: "IMPORT_FORMAT_QIF",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_OFX":         2,
+		"IMPORT_FORMAT_QIF":         3,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_master_master_proto_enumTypes[3].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_master_master_proto_enumTypes[3]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{3}
+}
+
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0
+	ImportRowStatus_IMPORT_ROW_STATUS_ACCEPTED    ImportRowStatus = 1
+	ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED     ImportRowStatus = 2
+	ImportRowStatus_IMPORT_ROW_STATUS_ERROR       ImportRowStatus = 3
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_STATUS_ACCEPTED",
+		2: "IMPORT_ROW_STATUS_SKIPPED",
+		3: "IMPORT_ROW_STATUS_ERROR",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_STATUS_ACCEPTED":    1,
+		"IMPORT_ROW_STATUS_SKIPPED":     2,
+		"IMPORT_ROW_STATUS_ERROR":       3,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_master_master_proto_enumTypes[4].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_master_master_proto_enumTypes[4]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{4}
+}
+
 type CreateTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -36,6 +300,7 @@ type CreateTransactionRequest struct {
 	ToAccountId   string                 `protobuf:"bytes,6,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
 	Date          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
 	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	ToAmount      *common.Money          `protobuf:"bytes,9,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +391,13 @@ func (x *CreateTransactionRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateTransactionRequest) GetToAmount() *common.Money {
+	if x != nil {
+		return x.ToAmount
+	}
+	return nil
+}
+
 type CreateTransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transaction   *wallet.Transaction    `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
@@ -170,27 +442,36 @@ func (x *CreateTransactionResponse) GetTransaction() *wallet.Transaction {
 	return nil
 }
 
-type GetTransactionsRequest struct {
+type UpdateTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          common.TransactionType `protobuf:"varint,3,opt,name=type,proto3,enum=common.TransactionType" json:"type,omitempty"`
+	Amount        *common.Money          `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	FromAccountId string                 `protobuf:"bytes,6,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId   string                 `protobuf:"bytes,7,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	ToAmount      *common.Money          `protobuf:"bytes,10,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsRequest) Reset() {
-	*x = GetTransactionsRequest{}
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
 	mi := &file_master_master_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsRequest) String() string {
+func (x *UpdateTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsRequest) ProtoMessage() {}
+func (*UpdateTransactionRequest) ProtoMessage() {}
 
-func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -202,39 +483,102 @@ func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetTransactionsRequest) GetUserId() string {
+func (x *UpdateTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetTransactionsResponse struct {
+func (x *UpdateTransactionRequest) GetType() common.TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return common.TransactionType(0)
+}
+
+func (x *UpdateTransactionRequest) GetAmount() *common.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *UpdateTransactionRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *UpdateTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetToAmount() *common.Money {
+	if x != nil {
+		return x.ToAmount
+	}
+	return nil
+}
+
+type UpdateTransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*wallet.Transaction  `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Transaction   *wallet.Transaction    `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsResponse) Reset() {
-	*x = GetTransactionsResponse{}
+func (x *UpdateTransactionResponse) Reset() {
+	*x = UpdateTransactionResponse{}
 	mi := &file_master_master_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsResponse) String() string {
+func (x *UpdateTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsResponse) ProtoMessage() {}
+func (*UpdateTransactionResponse) ProtoMessage() {}
 
-func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,39 +590,40 @@ func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetTransactionsResponse) GetTransactions() []*wallet.Transaction {
+func (x *UpdateTransactionResponse) GetTransaction() *wallet.Transaction {
 	if x != nil {
-		return x.Transactions
+		return x.Transaction
 	}
 	return nil
 }
 
-type GetBalanceRequest struct {
+type DeleteTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceRequest) Reset() {
-	*x = GetBalanceRequest{}
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
 	mi := &file_master_master_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceRequest) String() string {
+func (x *DeleteTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceRequest) ProtoMessage() {}
+func (*DeleteTransactionRequest) ProtoMessage() {}
 
-func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -290,40 +635,45 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
-func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetBalanceRequest) GetUserId() string {
+func (x *DeleteTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *DeleteTransactionRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetBalanceResponse struct {
+type DeleteTransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalBalance  *common.Money          `protobuf:"bytes,1,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
-	Accounts      []*wallet.Account      `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceResponse) Reset() {
-	*x = GetBalanceResponse{}
+func (x *DeleteTransactionResponse) Reset() {
+	*x = DeleteTransactionResponse{}
 	mi := &file_master_master_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceResponse) String() string {
+func (x *DeleteTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceResponse) ProtoMessage() {}
+func (*DeleteTransactionResponse) ProtoMessage() {}
 
-func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,48 +685,43 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetBalanceResponse) GetTotalBalance() *common.Money {
-	if x != nil {
-		return x.TotalBalance
-	}
-	return nil
-}
-
-func (x *GetBalanceResponse) GetAccounts() []*wallet.Account {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
-}
-
-type GetAnalyticsRequest struct {
+type GetTransactionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	AccountIds    []string               `protobuf:"bytes,6,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Type          common.TransactionType `protobuf:"varint,7,opt,name=type,proto3,enum=common.TransactionType" json:"type,omitempty"`
+	CategoryIds   []string               `protobuf:"bytes,8,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	MinAmount     *int64                 `protobuf:"varint,9,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount     *int64                 `protobuf:"varint,10,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	Description   string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	Tags          []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnalyticsRequest) Reset() {
-	*x = GetAnalyticsRequest{}
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
 	mi := &file_master_master_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnalyticsRequest) String() string {
+func (x *GetTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnalyticsRequest) ProtoMessage() {}
+func (*GetTransactionsRequest) ProtoMessage() {}
 
-func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -388,54 +733,7749 @@ func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnalyticsRequest.ProtoReflect.Descriptor instead.
-func (*GetAnalyticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetAnalyticsRequest) GetUserId() string {
+func (x *GetTransactionsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetAnalyticsRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *GetTransactionsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.StartDate
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *GetAnalyticsRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *GetTransactionsRequest) GetPageToken() string {
 	if x != nil {
-		return x.EndDate
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-type GetAnalyticsResponse struct {
+func (x *GetTransactionsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetType() common.TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return common.TransactionType(0)
+}
+
+func (x *GetTransactionsRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetMinAmount() int64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetMaxAmount() int64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*wallet.Transaction  `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsResponse) Reset() {
+	*x = GetTransactionsResponse{}
+	mi := &file_master_master_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTransactionsResponse) GetTransactions() []*wallet.Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_master_master_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBalanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBalanceRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetBalanceResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalBalance    *common.Money          `protobuf:"bytes,1,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	Accounts        []*wallet.Account      `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	AccountBalances []*AccountBalance      `protobuf:"bytes,3,rep,name=account_balances,json=accountBalances,proto3" json:"account_balances,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_master_master_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBalanceResponse) GetTotalBalance() *common.Money {
+	if x != nil {
+		return x.TotalBalance
+	}
+	return nil
+}
+
+func (x *GetBalanceResponse) GetAccounts() []*wallet.Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetBalanceResponse) GetAccountBalances() []*AccountBalance {
+	if x != nil {
+		return x.AccountBalances
+	}
+	return nil
+}
+
+type AccountBalance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Balance          *common.Money          `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	ConvertedBalance *common.Money          `protobuf:"bytes,3,opt,name=converted_balance,json=convertedBalance,proto3" json:"converted_balance,omitempty"`
+	Rate             string                 `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	RateDate         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rate_date,json=rateDate,proto3" json:"rate_date,omitempty"`
+	RateMissing      bool                   `protobuf:"varint,6,opt,name=rate_missing,json=rateMissing,proto3" json:"rate_missing,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AccountBalance) Reset() {
+	*x = AccountBalance{}
+	mi := &file_master_master_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalance) ProtoMessage() {}
+
+func (x *AccountBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AccountBalance) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetBalance() *common.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetConvertedBalance() *common.Money {
+	if x != nil {
+		return x.ConvertedBalance
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetRateDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RateDate
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetRateMissing() bool {
+	if x != nil {
+		return x.RateMissing
+	}
+	return false
+}
+
+type CreateAccountRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           common.AccountType     `protobuf:"varint,3,opt,name=type,proto3,enum=common.AccountType" json:"type,omitempty"`
+	InitialBalance *common.Money          `protobuf:"bytes,4,opt,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_master_master_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetType() common.AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return common.AccountType(0)
+}
+
+func (x *CreateAccountRequest) GetInitialBalance() *common.Money {
+	if x != nil {
+		return x.InitialBalance
+	}
+	return nil
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *wallet.Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_master_master_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateAccountResponse) GetAccount() *wallet.Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type UpdateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
+	mi := &file_master_master_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *wallet.Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountResponse) Reset() {
+	*x = UpdateAccountResponse{}
+	mi := &file_master_master_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountResponse) ProtoMessage() {}
+
+func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateAccountResponse) GetAccount() *wallet.Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ArchiveAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Archived      bool                   `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAccountRequest) Reset() {
+	*x = ArchiveAccountRequest{}
+	mi := &file_master_master_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAccountRequest) ProtoMessage() {}
+
+func (x *ArchiveAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAccountRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveAccountRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ArchiveAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ArchiveAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ArchiveAccountRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ArchiveAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *wallet.Account        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAccountResponse) Reset() {
+	*x = ArchiveAccountResponse{}
+	mi := &file_master_master_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAccountResponse) ProtoMessage() {}
+
+func (x *ArchiveAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAccountResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveAccountResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ArchiveAccountResponse) GetAccount() *wallet.Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AccountId           string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReassignToAccountId string                 `protobuf:"bytes,3,opt,name=reassign_to_account_id,json=reassignToAccountId,proto3" json:"reassign_to_account_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_master_master_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetReassignToAccountId() string {
+	if x != nil {
+		return x.ReassignToAccountId
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_master_master_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{18}
+}
+
+type GetAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	GroupBy       common.TimePeriod      `protobuf:"varint,4,opt,name=group_by,json=groupBy,proto3,enum=common.TimePeriod" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsRequest) Reset() {
+	*x = GetAnalyticsRequest{}
+	mi := &file_master_master_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAnalyticsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAnalyticsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetAnalyticsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetAnalyticsRequest) GetGroupBy() common.TimePeriod {
+	if x != nil {
+		return x.GroupBy
+	}
+	return common.TimePeriod(0)
+}
+
+type GetAnalyticsResponse struct {
 	state         protoimpl.MessageState          `protogen:"open.v1"`
 	Statistics    *analyzer.GetStatisticsResponse `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnalyticsResponse) Reset() {
-	*x = GetAnalyticsResponse{}
-	mi := &file_master_master_proto_msgTypes[7]
+func (x *GetAnalyticsResponse) Reset() {
+	*x = GetAnalyticsResponse{}
+	mi := &file_master_master_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAnalyticsResponse) GetStatistics() *analyzer.GetStatisticsResponse {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type GetForecastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	PeriodsAhead  int32                  `protobuf:"varint,3,opt,name=periods_ahead,json=periodsAhead,proto3" json:"periods_ahead,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastRequest) Reset() {
+	*x = GetForecastRequest{}
+	mi := &file_master_master_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastRequest) ProtoMessage() {}
+
+func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetForecastRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetForecastRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetForecastRequest) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+func (x *GetForecastRequest) GetPeriodsAhead() int32 {
+	if x != nil {
+		return x.PeriodsAhead
+	}
+	return 0
+}
+
+type GetForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forecasts     []*analyzer.Forecast   `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastResponse) Reset() {
+	*x = GetForecastResponse{}
+	mi := &file_master_master_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastResponse) ProtoMessage() {}
+
+func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetForecastResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetForecastResponse) GetForecasts() []*analyzer.Forecast {
+	if x != nil {
+		return x.Forecasts
+	}
+	return nil
+}
+
+type GetInvestmentPositionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestmentPositionsRequest) Reset() {
+	*x = GetInvestmentPositionsRequest{}
+	mi := &file_master_master_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestmentPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestmentPositionsRequest) ProtoMessage() {}
+
+func (x *GetInvestmentPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestmentPositionsRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestmentPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetInvestmentPositionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetInvestmentPositionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetInvestmentPositionsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Positions     []*market.InvestmentPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestmentPositionsResponse) Reset() {
+	*x = GetInvestmentPositionsResponse{}
+	mi := &file_master_master_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestmentPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestmentPositionsResponse) ProtoMessage() {}
+
+func (x *GetInvestmentPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestmentPositionsResponse.ProtoReflect.Descriptor instead.
+func (*GetInvestmentPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetInvestmentPositionsResponse) GetPositions() []*market.InvestmentPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetSecurityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Figi          string                 `protobuf:"bytes,1,opt,name=figi,proto3" json:"figi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityRequest) Reset() {
+	*x = GetSecurityRequest{}
+	mi := &file_master_master_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityRequest) ProtoMessage() {}
+
+func (x *GetSecurityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityRequest.ProtoReflect.Descriptor instead.
+func (*GetSecurityRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSecurityRequest) GetFigi() string {
+	if x != nil {
+		return x.Figi
+	}
+	return ""
+}
+
+type GetSecurityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Security      *market.Security       `protobuf:"bytes,1,opt,name=security,proto3" json:"security,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityResponse) Reset() {
+	*x = GetSecurityResponse{}
+	mi := &file_master_master_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityResponse) ProtoMessage() {}
+
+func (x *GetSecurityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityResponse.ProtoReflect.Descriptor instead.
+func (*GetSecurityResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSecurityResponse) GetSecurity() *market.Security {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+type GetSecuritiesPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Figis         []string               `protobuf:"bytes,1,rep,name=figis,proto3" json:"figis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecuritiesPricesRequest) Reset() {
+	*x = GetSecuritiesPricesRequest{}
+	mi := &file_master_master_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecuritiesPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecuritiesPricesRequest) ProtoMessage() {}
+
+func (x *GetSecuritiesPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecuritiesPricesRequest.ProtoReflect.Descriptor instead.
+func (*GetSecuritiesPricesRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetSecuritiesPricesRequest) GetFigis() []string {
+	if x != nil {
+		return x.Figis
+	}
+	return nil
+}
+
+type GetSecuritiesPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Securities    []*market.Security     `protobuf:"bytes,1,rep,name=securities,proto3" json:"securities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecuritiesPricesResponse) Reset() {
+	*x = GetSecuritiesPricesResponse{}
+	mi := &file_master_master_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecuritiesPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecuritiesPricesResponse) ProtoMessage() {}
+
+func (x *GetSecuritiesPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecuritiesPricesResponse.ProtoReflect.Descriptor instead.
+func (*GetSecuritiesPricesResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetSecuritiesPricesResponse) GetSecurities() []*market.Security {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+type GetSecurityPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Figis         []string               `protobuf:"bytes,1,rep,name=figis,proto3" json:"figis,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityPaymentsRequest) Reset() {
+	*x = GetSecurityPaymentsRequest{}
+	mi := &file_master_master_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityPaymentsRequest) ProtoMessage() {}
+
+func (x *GetSecurityPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetSecurityPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetSecurityPaymentsRequest) GetFigis() []string {
+	if x != nil {
+		return x.Figis
+	}
+	return nil
+}
+
+func (x *GetSecurityPaymentsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetSecurityPaymentsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetSecurityPaymentsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Payments      []*market.SecurityPayment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityPaymentsResponse) Reset() {
+	*x = GetSecurityPaymentsResponse{}
+	mi := &file_master_master_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityPaymentsResponse) ProtoMessage() {}
+
+func (x *GetSecurityPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*GetSecurityPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSecurityPaymentsResponse) GetPayments() []*market.SecurityPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type BrokerLink struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AccountId         string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	BackendType       string                 `protobuf:"bytes,2,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"`
+	ExternalAccountId string                 `protobuf:"bytes,3,opt,name=external_account_id,json=externalAccountId,proto3" json:"external_account_id,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BrokerLink) Reset() {
+	*x = BrokerLink{}
+	mi := &file_master_master_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrokerLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrokerLink) ProtoMessage() {}
+
+func (x *BrokerLink) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrokerLink.ProtoReflect.Descriptor instead.
+func (*BrokerLink) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BrokerLink) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *BrokerLink) GetBackendType() string {
+	if x != nil {
+		return x.BackendType
+	}
+	return ""
+}
+
+func (x *BrokerLink) GetExternalAccountId() string {
+	if x != nil {
+		return x.ExternalAccountId
+	}
+	return ""
+}
+
+func (x *BrokerLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BrokerLink) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type LinkBrokerRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId         string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	BackendType       string                 `protobuf:"bytes,3,opt,name=backend_type,json=backendType,proto3" json:"backend_type,omitempty"`
+	ExternalAccountId string                 `protobuf:"bytes,4,opt,name=external_account_id,json=externalAccountId,proto3" json:"external_account_id,omitempty"`
+	Token             string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LinkBrokerRequest) Reset() {
+	*x = LinkBrokerRequest{}
+	mi := &file_master_master_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkBrokerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkBrokerRequest) ProtoMessage() {}
+
+func (x *LinkBrokerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkBrokerRequest.ProtoReflect.Descriptor instead.
+func (*LinkBrokerRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LinkBrokerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkBrokerRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *LinkBrokerRequest) GetBackendType() string {
+	if x != nil {
+		return x.BackendType
+	}
+	return ""
+}
+
+func (x *LinkBrokerRequest) GetExternalAccountId() string {
+	if x != nil {
+		return x.ExternalAccountId
+	}
+	return ""
+}
+
+func (x *LinkBrokerRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type LinkBrokerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *BrokerLink            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkBrokerResponse) Reset() {
+	*x = LinkBrokerResponse{}
+	mi := &file_master_master_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkBrokerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkBrokerResponse) ProtoMessage() {}
+
+func (x *LinkBrokerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkBrokerResponse.ProtoReflect.Descriptor instead.
+func (*LinkBrokerResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LinkBrokerResponse) GetLink() *BrokerLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type UnlinkBrokerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkBrokerRequest) Reset() {
+	*x = UnlinkBrokerRequest{}
+	mi := &file_master_master_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkBrokerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkBrokerRequest) ProtoMessage() {}
+
+func (x *UnlinkBrokerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkBrokerRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkBrokerRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnlinkBrokerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlinkBrokerRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type UnlinkBrokerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkBrokerResponse) Reset() {
+	*x = UnlinkBrokerResponse{}
+	mi := &file_master_master_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkBrokerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkBrokerResponse) ProtoMessage() {}
+
+func (x *UnlinkBrokerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkBrokerResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkBrokerResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{35}
+}
+
+type GetNetWorthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetWorthRequest) Reset() {
+	*x = GetNetWorthRequest{}
+	mi := &file_master_master_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetWorthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetWorthRequest) ProtoMessage() {}
+
+func (x *GetNetWorthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetWorthRequest.ProtoReflect.Descriptor instead.
+func (*GetNetWorthRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetNetWorthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetNetWorthRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetNetWorthResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Total            *common.Money           `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	CashTotal        *common.Money           `protobuf:"bytes,2,opt,name=cash_total,json=cashTotal,proto3" json:"cash_total,omitempty"`
+	InvestmentsTotal *common.Money           `protobuf:"bytes,3,opt,name=investments_total,json=investmentsTotal,proto3" json:"investments_total,omitempty"`
+	Accounts         []*NetWorthAccount      `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Securities       []*NetWorthSecurity     `protobuf:"bytes,5,rep,name=securities,proto3" json:"securities,omitempty"`
+	SecurityTypes    []*NetWorthSecurityType `protobuf:"bytes,6,rep,name=security_types,json=securityTypes,proto3" json:"security_types,omitempty"`
+	ValuedAt         *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=valued_at,json=valuedAt,proto3" json:"valued_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetNetWorthResponse) Reset() {
+	*x = GetNetWorthResponse{}
+	mi := &file_master_master_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetWorthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetWorthResponse) ProtoMessage() {}
+
+func (x *GetNetWorthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetWorthResponse.ProtoReflect.Descriptor instead.
+func (*GetNetWorthResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetNetWorthResponse) GetTotal() *common.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetNetWorthResponse) GetCashTotal() *common.Money {
+	if x != nil {
+		return x.CashTotal
+	}
+	return nil
+}
+
+func (x *GetNetWorthResponse) GetInvestmentsTotal() *common.Money {
+	if x != nil {
+		return x.InvestmentsTotal
+	}
+	return nil
+}
+
+func (x *GetNetWorthResponse) GetAccounts() []*NetWorthAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetNetWorthResponse) GetSecurities() []*NetWorthSecurity {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *GetNetWorthResponse) GetSecurityTypes() []*NetWorthSecurityType {
+	if x != nil {
+		return x.SecurityTypes
+	}
+	return nil
+}
+
+func (x *GetNetWorthResponse) GetValuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValuedAt
+	}
+	return nil
+}
+
+type NetWorthAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          common.AccountType     `protobuf:"varint,3,opt,name=type,proto3,enum=common.AccountType" json:"type,omitempty"`
+	Value         *common.Money          `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	ValuedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valued_at,json=valuedAt,proto3" json:"valued_at,omitempty"`
+	RateMissing   bool                   `protobuf:"varint,6,opt,name=rate_missing,json=rateMissing,proto3" json:"rate_missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetWorthAccount) Reset() {
+	*x = NetWorthAccount{}
+	mi := &file_master_master_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetWorthAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetWorthAccount) ProtoMessage() {}
+
+func (x *NetWorthAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetWorthAccount.ProtoReflect.Descriptor instead.
+func (*NetWorthAccount) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NetWorthAccount) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *NetWorthAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetWorthAccount) GetType() common.AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return common.AccountType(0)
+}
+
+func (x *NetWorthAccount) GetValue() *common.Money {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *NetWorthAccount) GetValuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValuedAt
+	}
+	return nil
+}
+
+func (x *NetWorthAccount) GetRateMissing() bool {
+	if x != nil {
+		return x.RateMissing
+	}
+	return false
+}
+
+type NetWorthSecurity struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Figi           string                 `protobuf:"bytes,1,opt,name=figi,proto3" json:"figi,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Quantity       int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price          *common.Money          `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Value          *common.Money          `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	PriceUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=price_updated_at,json=priceUpdatedAt,proto3" json:"price_updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NetWorthSecurity) Reset() {
+	*x = NetWorthSecurity{}
+	mi := &file_master_master_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetWorthSecurity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetWorthSecurity) ProtoMessage() {}
+
+func (x *NetWorthSecurity) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetWorthSecurity.ProtoReflect.Descriptor instead.
+func (*NetWorthSecurity) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NetWorthSecurity) GetFigi() string {
+	if x != nil {
+		return x.Figi
+	}
+	return ""
+}
+
+func (x *NetWorthSecurity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetWorthSecurity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NetWorthSecurity) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *NetWorthSecurity) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *NetWorthSecurity) GetValue() *common.Money {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *NetWorthSecurity) GetPriceUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceUpdatedAt
+	}
+	return nil
+}
+
+type NetWorthSecurityType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value         *common.Money          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetWorthSecurityType) Reset() {
+	*x = NetWorthSecurityType{}
+	mi := &file_master_master_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetWorthSecurityType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetWorthSecurityType) ProtoMessage() {}
+
+func (x *NetWorthSecurityType) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetWorthSecurityType.ProtoReflect.Descriptor instead.
+func (*NetWorthSecurityType) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NetWorthSecurityType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NetWorthSecurityType) GetValue() *common.Money {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type GetAnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnomaliesRequest) Reset() {
+	*x = GetAnomaliesRequest{}
+	mi := &file_master_master_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnomaliesRequest) ProtoMessage() {}
+
+func (x *GetAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*GetAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetAnomaliesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAnomaliesRequest) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+type GetAnomaliesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Anomalies     []*analyzer.CategoryAnomaly `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnomaliesResponse) Reset() {
+	*x = GetAnomaliesResponse{}
+	mi := &file_master_master_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnomaliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnomaliesResponse) ProtoMessage() {}
+
+func (x *GetAnomaliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnomaliesResponse.ProtoReflect.Descriptor instead.
+func (*GetAnomaliesResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetAnomaliesResponse) GetAnomalies() []*analyzer.CategoryAnomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+type GetUpcomingRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpcomingRecurringRequest) Reset() {
+	*x = GetUpcomingRecurringRequest{}
+	mi := &file_master_master_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpcomingRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingRecurringRequest) ProtoMessage() {}
+
+func (x *GetUpcomingRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingRecurringRequest.ProtoReflect.Descriptor instead.
+func (*GetUpcomingRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetUpcomingRecurringRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUpcomingRecurringResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Payments      []*analyzer.RecurringPayment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpcomingRecurringResponse) Reset() {
+	*x = GetUpcomingRecurringResponse{}
+	mi := &file_master_master_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpcomingRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingRecurringResponse) ProtoMessage() {}
+
+func (x *GetUpcomingRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingRecurringResponse.ProtoReflect.Descriptor instead.
+func (*GetUpcomingRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetUpcomingRecurringResponse) GetPayments() []*analyzer.RecurringPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type Notification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SentAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	ReadAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	Read           bool                   `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty"`
+	Status         string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_master_master_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Notification) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *Notification) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *Notification) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,4,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_master_master_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListNotificationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_master_master_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MarkNotificationsReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NotificationIds []string               `protobuf:"bytes,2,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	Read            bool                   `protobuf:"varint,3,opt,name=read,proto3" json:"read,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_master_master_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MarkNotificationsReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkNotificationsReadRequest) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadRequest) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+type MarkNotificationsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedCount  int64                  `protobuf:"varint,1,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	mi := &file_master_master_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MarkNotificationsReadResponse) GetUpdatedCount() int64 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+type DeleteNotificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NotificationId string                 `protobuf:"bytes,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationRequest) Reset() {
+	*x = DeleteNotificationRequest{}
+	mi := &file_master_master_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteNotificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+type DeleteNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationResponse) Reset() {
+	*x = DeleteNotificationResponse{}
+	mi := &file_master_master_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationResponse) ProtoMessage() {}
+
+func (x *DeleteNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{51}
+}
+
+type GetUnreadNotificationsCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadNotificationsCountRequest) Reset() {
+	*x = GetUnreadNotificationsCountRequest{}
+	mi := &file_master_master_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadNotificationsCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationsCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadNotificationsCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationsCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationsCountRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetUnreadNotificationsCountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUnreadNotificationsCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadNotificationsCountResponse) Reset() {
+	*x = GetUnreadNotificationsCountResponse{}
+	mi := &file_master_master_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadNotificationsCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationsCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadNotificationsCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationsCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationsCountResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetUnreadNotificationsCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BudgetId      string                 `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,4,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	Limit         *common.Money          `protobuf:"bytes,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_master_master_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Budget) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *Budget) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Budget) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Budget) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+func (x *Budget) GetLimit() *common.Money {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *Budget) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BudgetStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	Spent         *common.Money          `protobuf:"bytes,2,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining     *common.Money          `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	UsedPercent   float64                `protobuf:"fixed64,4,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetStatus) Reset() {
+	*x = BudgetStatus{}
+	mi := &file_master_master_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetStatus) ProtoMessage() {}
+
+func (x *BudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetStatus.ProtoReflect.Descriptor instead.
+func (*BudgetStatus) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BudgetStatus) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetSpent() *common.Money {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetRemaining() *common.Money {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+type CreateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,3,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	Limit         *common.Money          `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBudgetRequest) Reset() {
+	*x = CreateBudgetRequest{}
+	mi := &file_master_master_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetRequest) ProtoMessage() {}
+
+func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateBudgetRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateBudgetRequest) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+func (x *CreateBudgetRequest) GetLimit() *common.Money {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type CreateBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBudgetResponse) Reset() {
+	*x = CreateBudgetResponse{}
+	mi := &file_master_master_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetResponse) ProtoMessage() {}
+
+func (x *CreateBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetResponse.ProtoReflect.Descriptor instead.
+func (*CreateBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateBudgetResponse) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type UpdateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BudgetId      string                 `protobuf:"bytes,2,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	Limit         *common.Money          `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetRequest) Reset() {
+	*x = UpdateBudgetRequest{}
+	mi := &file_master_master_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetRequest) ProtoMessage() {}
+
+func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateBudgetRequest) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *UpdateBudgetRequest) GetLimit() *common.Money {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type UpdateBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetResponse) Reset() {
+	*x = UpdateBudgetResponse{}
+	mi := &file_master_master_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetResponse) ProtoMessage() {}
+
+func (x *UpdateBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateBudgetResponse) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BudgetId      string                 `protobuf:"bytes,2,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_master_master_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteBudgetRequest) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+type DeleteBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetResponse) Reset() {
+	*x = DeleteBudgetResponse{}
+	mi := &file_master_master_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{61}
+}
+
+type ListBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsRequest) Reset() {
+	*x = ListBudgetsRequest{}
+	mi := &file_master_master_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsRequest) ProtoMessage() {}
+
+func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListBudgetsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsResponse) Reset() {
+	*x = ListBudgetsResponse{}
+	mi := &file_master_master_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsResponse) ProtoMessage() {}
+
+func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type GetBudgetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusRequest) Reset() {
+	*x = GetBudgetStatusRequest{}
+	mi := &file_master_master_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusRequest) ProtoMessage() {}
+
+func (x *GetBudgetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetBudgetStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBudgetStatusRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type GetBudgetStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*BudgetStatus        `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusResponse) Reset() {
+	*x = GetBudgetStatusResponse{}
+	mi := &file_master_master_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusResponse) ProtoMessage() {}
+
+func (x *GetBudgetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetBudgetStatusResponse) GetStatuses() []*BudgetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type Goal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        string                 `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Target        *common.Money          `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AccountId     string                 `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_master_master_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Goal) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *Goal) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Goal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Goal) GetTarget() *common.Money {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Goal) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Goal) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Goal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GoalProgress struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Goal                *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	Current             *common.Money          `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	Remaining           *common.Money          `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	ProgressPercent     float64                `protobuf:"fixed64,4,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	ProjectedCompletion *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=projected_completion,json=projectedCompletion,proto3" json:"projected_completion,omitempty"`
+	OnTrack             bool                   `protobuf:"varint,6,opt,name=on_track,json=onTrack,proto3" json:"on_track,omitempty"`
+	AccountState        GoalAccountState       `protobuf:"varint,7,opt,name=account_state,json=accountState,proto3,enum=master.GoalAccountState" json:"account_state,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	mi := &file_master_master_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress) ProtoMessage() {}
+
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GoalProgress) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetCurrent() *common.Money {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetRemaining() *common.Money {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetProgressPercent() float64 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetProjectedCompletion() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProjectedCompletion
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetOnTrack() bool {
+	if x != nil {
+		return x.OnTrack
+	}
+	return false
+}
+
+func (x *GoalProgress) GetAccountState() GoalAccountState {
+	if x != nil {
+		return x.AccountState
+	}
+	return GoalAccountState_GOAL_ACCOUNT_STATE_UNSPECIFIED
+}
+
+type CreateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Target        *common.Money          `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AccountId     string                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoalRequest) Reset() {
+	*x = CreateGoalRequest{}
+	mi := &file_master_master_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalRequest) ProtoMessage() {}
+
+func (x *CreateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetTarget() *common.Money {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type CreateGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoalResponse) Reset() {
+	*x = CreateGoalResponse{}
+	mi := &file_master_master_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalResponse) ProtoMessage() {}
+
+func (x *CreateGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalResponse.ProtoReflect.Descriptor instead.
+func (*CreateGoalResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateGoalResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type UpdateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        string                 `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Target        *common.Money          `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AccountId     string                 `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoalRequest) Reset() {
+	*x = UpdateGoalRequest{}
+	mi := &file_master_master_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalRequest) ProtoMessage() {}
+
+func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetTarget() *common.Money {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *UpdateGoalRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *UpdateGoalRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type UpdateGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoalResponse) Reset() {
+	*x = UpdateGoalResponse{}
+	mi := &file_master_master_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalResponse) ProtoMessage() {}
+
+func (x *UpdateGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGoalResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateGoalResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type DeleteGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        string                 `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoalRequest) Reset() {
+	*x = DeleteGoalRequest{}
+	mi := &file_master_master_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalRequest) ProtoMessage() {}
+
+func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteGoalRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+type DeleteGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoalResponse) Reset() {
+	*x = DeleteGoalResponse{}
+	mi := &file_master_master_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalResponse) ProtoMessage() {}
+
+func (x *DeleteGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGoalResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{73}
+}
+
+type GetGoalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalsRequest) Reset() {
+	*x = GetGoalsRequest{}
+	mi := &file_master_master_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalsRequest) ProtoMessage() {}
+
+func (x *GetGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalsRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetGoalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*GoalProgress        `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalsResponse) Reset() {
+	*x = GetGoalsResponse{}
+	mi := &file_master_master_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalsResponse) ProtoMessage() {}
+
+func (x *GetGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalsResponse.ProtoReflect.Descriptor instead.
+func (*GetGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetGoalsResponse) GetGoals() []*GoalProgress {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type AddGoalContributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        string                 `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGoalContributionRequest) Reset() {
+	*x = AddGoalContributionRequest{}
+	mi := &file_master_master_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGoalContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGoalContributionRequest) ProtoMessage() {}
+
+func (x *AddGoalContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGoalContributionRequest.ProtoReflect.Descriptor instead.
+func (*AddGoalContributionRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AddGoalContributionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddGoalContributionRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *AddGoalContributionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type AddGoalContributionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGoalContributionResponse) Reset() {
+	*x = AddGoalContributionResponse{}
+	mi := &file_master_master_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGoalContributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGoalContributionResponse) ProtoMessage() {}
+
+func (x *AddGoalContributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGoalContributionResponse.ProtoReflect.Descriptor instead.
+func (*AddGoalContributionResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{77}
+}
+
+type RemoveGoalContributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        string                 `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGoalContributionRequest) Reset() {
+	*x = RemoveGoalContributionRequest{}
+	mi := &file_master_master_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGoalContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGoalContributionRequest) ProtoMessage() {}
+
+func (x *RemoveGoalContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGoalContributionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGoalContributionRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RemoveGoalContributionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveGoalContributionRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *RemoveGoalContributionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type RemoveGoalContributionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGoalContributionResponse) Reset() {
+	*x = RemoveGoalContributionResponse{}
+	mi := &file_master_master_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGoalContributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGoalContributionResponse) ProtoMessage() {}
+
+func (x *RemoveGoalContributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGoalContributionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGoalContributionResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{79}
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                 `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	System        bool                   `protobuf:"varint,7,opt,name=system,proto3" json:"system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_master_master_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Category) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Category) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Category) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Category) GetSystem() bool {
+	if x != nil {
+		return x.System
+	}
+	return false
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_master_master_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListCategoriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_master_master_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color         string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	ParentId      string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_master_master_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CreateCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_master_master_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	ParentId      string                 `protobuf:"bytes,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_master_master_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_master_master_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_master_master_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DeleteCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_master_master_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{88}
+}
+
+type TransactionRule struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RuleId              string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position            int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Enabled             *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	DescriptionContains string                 `protobuf:"bytes,6,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
+	DescriptionRegex    string                 `protobuf:"bytes,7,opt,name=description_regex,json=descriptionRegex,proto3" json:"description_regex,omitempty"`
+	MinAmount           *int64                 `protobuf:"varint,8,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount           *int64                 `protobuf:"varint,9,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	AccountId           string                 `protobuf:"bytes,10,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Mcc                 *int32                 `protobuf:"varint,11,opt,name=mcc,proto3,oneof" json:"mcc,omitempty"`
+	SetCategoryId       string                 `protobuf:"bytes,12,opt,name=set_category_id,json=setCategoryId,proto3" json:"set_category_id,omitempty"`
+	SetDescription      string                 `protobuf:"bytes,13,opt,name=set_description,json=setDescription,proto3" json:"set_description,omitempty"`
+	SetTag              string                 `protobuf:"bytes,14,opt,name=set_tag,json=setTag,proto3" json:"set_tag,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TransactionRule) Reset() {
+	*x = TransactionRule{}
+	mi := &file_master_master_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRule) ProtoMessage() {}
+
+func (x *TransactionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRule.ProtoReflect.Descriptor instead.
+func (*TransactionRule) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *TransactionRule) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *TransactionRule) GetDescriptionContains() string {
+	if x != nil {
+		return x.DescriptionContains
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetDescriptionRegex() string {
+	if x != nil {
+		return x.DescriptionRegex
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetMinAmount() int64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetMaxAmount() int64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetMcc() int32 {
+	if x != nil && x.Mcc != nil {
+		return *x.Mcc
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetSetCategoryId() string {
+	if x != nil {
+		return x.SetCategoryId
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetSetDescription() string {
+	if x != nil {
+		return x.SetDescription
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetSetTag() string {
+	if x != nil {
+		return x.SetTag
+	}
+	return ""
+}
+
+type ListRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesRequest) Reset() {
+	*x = ListRulesRequest{}
+	mi := &file_master_master_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesRequest) ProtoMessage() {}
+
+func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListRulesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*TransactionRule     `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
+	mi := &file_master_master_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesResponse) ProtoMessage() {}
+
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListRulesResponse) GetRules() []*TransactionRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rule          *TransactionRule       `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRuleRequest) Reset() {
+	*x = CreateRuleRequest{}
+	mi := &file_master_master_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRuleRequest) ProtoMessage() {}
+
+func (x *CreateRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRuleRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRuleRequest) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TransactionRule       `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRuleResponse) Reset() {
+	*x = CreateRuleResponse{}
+	mi := &file_master_master_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRuleResponse) ProtoMessage() {}
+
+func (x *CreateRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRuleResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateRuleResponse) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Rule          *TransactionRule       `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRuleRequest) Reset() {
+	*x = UpdateRuleRequest{}
+	mi := &file_master_master_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleRequest) ProtoMessage() {}
+
+func (x *UpdateRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRuleRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *UpdateRuleRequest) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TransactionRule       `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRuleResponse) Reset() {
+	*x = UpdateRuleResponse{}
+	mi := &file_master_master_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleResponse) ProtoMessage() {}
+
+func (x *UpdateRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRuleResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateRuleResponse) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	mi := &file_master_master_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DeleteRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type DeleteRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleResponse) Reset() {
+	*x = DeleteRuleResponse{}
+	mi := &file_master_master_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleResponse) ProtoMessage() {}
+
+func (x *DeleteRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRuleResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{97}
+}
+
+type ReorderRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleIds       []string               `protobuf:"bytes,2,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRulesRequest) Reset() {
+	*x = ReorderRulesRequest{}
+	mi := &file_master_master_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRulesRequest) ProtoMessage() {}
+
+func (x *ReorderRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRulesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRulesRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ReorderRulesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderRulesRequest) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+type ReorderRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*TransactionRule     `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRulesResponse) Reset() {
+	*x = ReorderRulesResponse{}
+	mi := &file_master_master_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRulesResponse) ProtoMessage() {}
+
+func (x *ReorderRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRulesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderRulesResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ReorderRulesResponse) GetRules() []*TransactionRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type RuleChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *wallet.Transaction    `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleChange) Reset() {
+	*x = RuleChange{}
+	mi := &file_master_master_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleChange) ProtoMessage() {}
+
+func (x *RuleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleChange.ProtoReflect.Descriptor instead.
+func (*RuleChange) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RuleChange) GetTransaction() *wallet.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *RuleChange) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *RuleChange) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RuleChange) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type DryRunRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rule          *TransactionRule       `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DryRunRuleRequest) Reset() {
+	*x = DryRunRuleRequest{}
+	mi := &file_master_master_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunRuleRequest) ProtoMessage() {}
+
+func (x *DryRunRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunRuleRequest.ProtoReflect.Descriptor instead.
+func (*DryRunRuleRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DryRunRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DryRunRuleRequest) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *DryRunRuleRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DryRunRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*RuleChange          `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Truncated     bool                   `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DryRunRuleResponse) Reset() {
+	*x = DryRunRuleResponse{}
+	mi := &file_master_master_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunRuleResponse) ProtoMessage() {}
+
+func (x *DryRunRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunRuleResponse.ProtoReflect.Descriptor instead.
+func (*DryRunRuleResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DryRunRuleResponse) GetChanges() []*RuleChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DryRunRuleResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type ApplyRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRulesRequest) Reset() {
+	*x = ApplyRulesRequest{}
+	mi := &file_master_master_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRulesRequest) ProtoMessage() {}
+
+func (x *ApplyRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRulesRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ApplyRulesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ApplyRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedCount  int64                  `protobuf:"varint,1,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRulesResponse) Reset() {
+	*x = ApplyRulesResponse{}
+	mi := &file_master_master_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRulesResponse) ProtoMessage() {}
+
+func (x *ApplyRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRulesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyRulesResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ApplyRulesResponse) GetUpdatedCount() int64 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+type ImportProfile struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId         string                 `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Delimiter         string                 `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	Encoding          string                 `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	DateFormat        string                 `protobuf:"bytes,6,opt,name=date_format,json=dateFormat,proto3" json:"date_format,omitempty"`
+	DecimalSeparator  string                 `protobuf:"bytes,7,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	SkipRows          int32                  `protobuf:"varint,8,opt,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"`
+	HasHeader         *bool                  `protobuf:"varint,9,opt,name=has_header,json=hasHeader,proto3,oneof" json:"has_header,omitempty"`
+	SignConvention    ImportSignConvention   `protobuf:"varint,10,opt,name=sign_convention,json=signConvention,proto3,enum=master.ImportSignConvention" json:"sign_convention,omitempty"`
+	DateColumn        string                 `protobuf:"bytes,11,opt,name=date_column,json=dateColumn,proto3" json:"date_column,omitempty"`
+	AmountColumn      string                 `protobuf:"bytes,12,opt,name=amount_column,json=amountColumn,proto3" json:"amount_column,omitempty"`
+	DebitColumn       string                 `protobuf:"bytes,13,opt,name=debit_column,json=debitColumn,proto3" json:"debit_column,omitempty"`
+	CreditColumn      string                 `protobuf:"bytes,14,opt,name=credit_column,json=creditColumn,proto3" json:"credit_column,omitempty"`
+	DescriptionColumn string                 `protobuf:"bytes,15,opt,name=description_column,json=descriptionColumn,proto3" json:"description_column,omitempty"`
+	MccColumn         string                 `protobuf:"bytes,16,opt,name=mcc_column,json=mccColumn,proto3" json:"mcc_column,omitempty"`
+	CurrencyColumn    string                 `protobuf:"bytes,17,opt,name=currency_column,json=currencyColumn,proto3" json:"currency_column,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportProfile) Reset() {
+	*x = ImportProfile{}
+	mi := &file_master_master_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProfile) ProtoMessage() {}
+
+func (x *ImportProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProfile.ProtoReflect.Descriptor instead.
+func (*ImportProfile) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ImportProfile) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDateFormat() string {
+	if x != nil {
+		return x.DateFormat
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetSkipRows() int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return 0
+}
+
+func (x *ImportProfile) GetHasHeader() bool {
+	if x != nil && x.HasHeader != nil {
+		return *x.HasHeader
+	}
+	return false
+}
+
+func (x *ImportProfile) GetSignConvention() ImportSignConvention {
+	if x != nil {
+		return x.SignConvention
+	}
+	return ImportSignConvention_IMPORT_SIGN_CONVENTION_UNSPECIFIED
+}
+
+func (x *ImportProfile) GetDateColumn() string {
+	if x != nil {
+		return x.DateColumn
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetAmountColumn() string {
+	if x != nil {
+		return x.AmountColumn
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDebitColumn() string {
+	if x != nil {
+		return x.DebitColumn
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetCreditColumn() string {
+	if x != nil {
+		return x.CreditColumn
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDescriptionColumn() string {
+	if x != nil {
+		return x.DescriptionColumn
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetMccColumn() string {
+	if x != nil {
+		return x.MccColumn
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetCurrencyColumn() string {
+	if x != nil {
+		return x.CurrencyColumn
+	}
+	return ""
+}
+
+type ListImportProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportProfilesRequest) Reset() {
+	*x = ListImportProfilesRequest{}
+	mi := &file_master_master_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportProfilesRequest) ProtoMessage() {}
+
+func (x *ListImportProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListImportProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListImportProfilesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListImportProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*ImportProfile       `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportProfilesResponse) Reset() {
+	*x = ListImportProfilesResponse{}
+	mi := &file_master_master_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportProfilesResponse) ProtoMessage() {}
+
+func (x *ListImportProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListImportProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListImportProfilesResponse) GetProfiles() []*ImportProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type CreateImportProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Profile       *ImportProfile         `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateImportProfileRequest) Reset() {
+	*x = CreateImportProfileRequest{}
+	mi := &file_master_master_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImportProfileRequest) ProtoMessage() {}
+
+func (x *CreateImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CreateImportProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateImportProfileRequest) GetProfile() *ImportProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type CreateImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *ImportProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateImportProfileResponse) Reset() {
+	*x = CreateImportProfileResponse{}
+	mi := &file_master_master_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImportProfileResponse) ProtoMessage() {}
+
+func (x *CreateImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateImportProfileResponse) GetProfile() *ImportProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateImportProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Profile       *ImportProfile         `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateImportProfileRequest) Reset() {
+	*x = UpdateImportProfileRequest{}
+	mi := &file_master_master_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateImportProfileRequest) ProtoMessage() {}
+
+func (x *UpdateImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateImportProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateImportProfileRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *UpdateImportProfileRequest) GetProfile() *ImportProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *ImportProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateImportProfileResponse) Reset() {
+	*x = UpdateImportProfileResponse{}
+	mi := &file_master_master_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateImportProfileResponse) ProtoMessage() {}
+
+func (x *UpdateImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateImportProfileResponse) GetProfile() *ImportProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type DeleteImportProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportProfileRequest) Reset() {
+	*x = DeleteImportProfileRequest{}
+	mi := &file_master_master_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportProfileRequest) ProtoMessage() {}
+
+func (x *DeleteImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeleteImportProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteImportProfileRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type DeleteImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportProfileResponse) Reset() {
+	*x = DeleteImportProfileResponse{}
+	mi := &file_master_master_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportProfileResponse) ProtoMessage() {}
+
+func (x *DeleteImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{113}
+}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Status        ImportRowStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=master.ImportRowStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Transaction   *wallet.Transaction    `protobuf:"bytes,4,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_master_master_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetStatus() ImportRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetTransaction() *wallet.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type ImportTransactionsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId         string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ProfileId         string                 `protobuf:"bytes,3,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Profile           *ImportProfile         `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	Content           []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	DryRun            bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Format            ImportFormat           `protobuf:"varint,7,opt,name=format,proto3,enum=master.ImportFormat" json:"format,omitempty"`
+	TransferAccountId string                 `protobuf:"bytes,8,opt,name=transfer_account_id,json=transferAccountId,proto3" json:"transfer_account_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportTransactionsRequest) Reset() {
+	*x = ImportTransactionsRequest{}
+	mi := &file_master_master_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTransactionsRequest) ProtoMessage() {}
+
+func (x *ImportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ImportTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportTransactionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ImportTransactionsRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *ImportTransactionsRequest) GetProfile() *ImportProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *ImportTransactionsRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportTransactionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTransactionsRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportTransactionsRequest) GetTransferAccountId() string {
+	if x != nil {
+		return x.TransferAccountId
+	}
+	return ""
+}
+
+type ImportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	AcceptedCount int32                  `protobuf:"varint,2,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	ErrorCount    int32                  `protobuf:"varint,4,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTransactionsResponse) Reset() {
+	*x = ImportTransactionsResponse{}
+	mi := &file_master_master_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTransactionsResponse) ProtoMessage() {}
+
+func (x *ImportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ImportTransactionsResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportTransactionsResponse) GetAcceptedCount() int32 {
+	if x != nil {
+		return x.AcceptedCount
+	}
+	return 0
+}
+
+func (x *ImportTransactionsResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportTransactionsResponse) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+type RecurringTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecurringId   string                 `protobuf:"bytes,1,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ToAccountId   string                 `protobuf:"bytes,4,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Type          common.TransactionType `protobuf:"varint,5,opt,name=type,proto3,enum=common.TransactionType" json:"type,omitempty"`
+	Amount        *common.Money          `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Frequency     RecurrenceFrequency    `protobuf:"varint,9,opt,name=frequency,proto3,enum=master.RecurrenceFrequency" json:"frequency,omitempty"`
+	Interval      int32                  `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"`
+	DayOfMonth    int32                  `protobuf:"varint,11,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Count         int32                  `protobuf:"varint,14,opt,name=count,proto3" json:"count,omitempty"`
+	NextRunAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	Paused        bool                   `protobuf:"varint,16,opt,name=paused,proto3" json:"paused,omitempty"`
+	LastError     string                 `protobuf:"bytes,17,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringTransaction) Reset() {
+	*x = RecurringTransaction{}
+	mi := &file_master_master_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringTransaction) ProtoMessage() {}
+
+func (x *RecurringTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringTransaction.ProtoReflect.Descriptor instead.
+func (*RecurringTransaction) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *RecurringTransaction) GetRecurringId() string {
+	if x != nil {
+		return x.RecurringId
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetType() common.TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return common.TransactionType(0)
+}
+
+func (x *RecurringTransaction) GetAmount() *common.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RecurringTransaction) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetFrequency() RecurrenceFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *RecurringTransaction) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *RecurringTransaction) GetDayOfMonth() int32 {
+	if x != nil {
+		return x.DayOfMonth
+	}
+	return 0
+}
+
+func (x *RecurringTransaction) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RecurringTransaction) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RecurringTransaction) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RecurringTransaction) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *RecurringTransaction) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *RecurringTransaction) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListRecurringTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringTransactionsRequest) Reset() {
+	*x = ListRecurringTransactionsRequest{}
+	mi := &file_master_master_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringTransactionsRequest) ProtoMessage() {}
+
+func (x *ListRecurringTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListRecurringTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListRecurringTransactionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Recurring     []*RecurringTransaction `protobuf:"bytes,1,rep,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringTransactionsResponse) Reset() {
+	*x = ListRecurringTransactionsResponse{}
+	mi := &file_master_master_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringTransactionsResponse) ProtoMessage() {}
+
+func (x *ListRecurringTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListRecurringTransactionsResponse) GetRecurring() []*RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+type CreateRecurringTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Recurring     *RecurringTransaction  `protobuf:"bytes,2,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringTransactionRequest) Reset() {
+	*x = CreateRecurringTransactionRequest{}
+	mi := &file_master_master_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringTransactionRequest) ProtoMessage() {}
+
+func (x *CreateRecurringTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CreateRecurringTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRecurringTransactionRequest) GetRecurring() *RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+type CreateRecurringTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     *RecurringTransaction  `protobuf:"bytes,1,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringTransactionResponse) Reset() {
+	*x = CreateRecurringTransactionResponse{}
+	mi := &file_master_master_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringTransactionResponse) ProtoMessage() {}
+
+func (x *CreateRecurringTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CreateRecurringTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CreateRecurringTransactionResponse) GetRecurring() *RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+type PauseRecurringTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecurringId   string                 `protobuf:"bytes,2,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRecurringTransactionRequest) Reset() {
+	*x = PauseRecurringTransactionRequest{}
+	mi := &file_master_master_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRecurringTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRecurringTransactionRequest) ProtoMessage() {}
+
+func (x *PauseRecurringTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRecurringTransactionRequest.ProtoReflect.Descriptor instead.
+func (*PauseRecurringTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *PauseRecurringTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PauseRecurringTransactionRequest) GetRecurringId() string {
+	if x != nil {
+		return x.RecurringId
+	}
+	return ""
+}
+
+func (x *PauseRecurringTransactionRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type PauseRecurringTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     *RecurringTransaction  `protobuf:"bytes,1,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRecurringTransactionResponse) Reset() {
+	*x = PauseRecurringTransactionResponse{}
+	mi := &file_master_master_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRecurringTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRecurringTransactionResponse) ProtoMessage() {}
+
+func (x *PauseRecurringTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRecurringTransactionResponse.ProtoReflect.Descriptor instead.
+func (*PauseRecurringTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PauseRecurringTransactionResponse) GetRecurring() *RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+type SkipRecurringTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecurringId   string                 `protobuf:"bytes,2,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipRecurringTransactionRequest) Reset() {
+	*x = SkipRecurringTransactionRequest{}
+	mi := &file_master_master_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipRecurringTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipRecurringTransactionRequest) ProtoMessage() {}
+
+func (x *SkipRecurringTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipRecurringTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SkipRecurringTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SkipRecurringTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SkipRecurringTransactionRequest) GetRecurringId() string {
+	if x != nil {
+		return x.RecurringId
+	}
+	return ""
+}
+
+type SkipRecurringTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     *RecurringTransaction  `protobuf:"bytes,1,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipRecurringTransactionResponse) Reset() {
+	*x = SkipRecurringTransactionResponse{}
+	mi := &file_master_master_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipRecurringTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipRecurringTransactionResponse) ProtoMessage() {}
+
+func (x *SkipRecurringTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipRecurringTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SkipRecurringTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SkipRecurringTransactionResponse) GetRecurring() *RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+type DeleteRecurringTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecurringId   string                 `protobuf:"bytes,2,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringTransactionRequest) Reset() {
+	*x = DeleteRecurringTransactionRequest{}
+	mi := &file_master_master_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteRecurringTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DeleteRecurringTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteRecurringTransactionRequest) GetRecurringId() string {
+	if x != nil {
+		return x.RecurringId
+	}
+	return ""
+}
+
+type DeleteRecurringTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringTransactionResponse) Reset() {
+	*x = DeleteRecurringTransactionResponse{}
+	mi := &file_master_master_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringTransactionResponse) ProtoMessage() {}
+
+func (x *DeleteRecurringTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{127}
+}
+
+type SetTransactionSplitsRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	UserId        string                     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId string                     `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Splits        []*wallet.TransactionSplit `protobuf:"bytes,3,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionSplitsRequest) Reset() {
+	*x = SetTransactionSplitsRequest{}
+	mi := &file_master_master_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionSplitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionSplitsRequest) ProtoMessage() {}
+
+func (x *SetTransactionSplitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionSplitsRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionSplitsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SetTransactionSplitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetTransactionSplitsRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SetTransactionSplitsRequest) GetSplits() []*wallet.TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type SetTransactionSplitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *wallet.Transaction    `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionSplitsResponse) Reset() {
+	*x = SetTransactionSplitsResponse{}
+	mi := &file_master_master_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionSplitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionSplitsResponse) ProtoMessage() {}
+
+func (x *SetTransactionSplitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionSplitsResponse.ProtoReflect.Descriptor instead.
+func (*SetTransactionSplitsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SetTransactionSplitsResponse) GetTransaction() *wallet.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UsageCount    int64                  `protobuf:"varint,4,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_master_master_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *Tag) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *Tag) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetUsageCount() int64 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *Tag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_master_master_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_master_master_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddTransactionTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionTagsRequest) Reset() {
+	*x = AddTransactionTagsRequest{}
+	mi := &file_master_master_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionTagsRequest) ProtoMessage() {}
+
+func (x *AddTransactionTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionTagsRequest.ProtoReflect.Descriptor instead.
+func (*AddTransactionTagsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *AddTransactionTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddTransactionTagsRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *AddTransactionTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddTransactionTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionTagsResponse) Reset() {
+	*x = AddTransactionTagsResponse{}
+	mi := &file_master_master_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionTagsResponse) ProtoMessage() {}
+
+func (x *AddTransactionTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionTagsResponse.ProtoReflect.Descriptor instead.
+func (*AddTransactionTagsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *AddTransactionTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RemoveTransactionTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTransactionTagsRequest) Reset() {
+	*x = RemoveTransactionTagsRequest{}
+	mi := &file_master_master_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTransactionTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTransactionTagsRequest) ProtoMessage() {}
+
+func (x *RemoveTransactionTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTransactionTagsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTransactionTagsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *RemoveTransactionTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveTransactionTagsRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RemoveTransactionTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RemoveTransactionTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTransactionTagsResponse) Reset() {
+	*x = RemoveTransactionTagsResponse{}
+	mi := &file_master_master_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTransactionTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTransactionTagsResponse) ProtoMessage() {}
+
+func (x *RemoveTransactionTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTransactionTagsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTransactionTagsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RemoveTransactionTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TagId            string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Income           *common.Money          `protobuf:"bytes,3,opt,name=income,proto3" json:"income,omitempty"`
+	Expense          *common.Money          `protobuf:"bytes,4,opt,name=expense,proto3" json:"expense,omitempty"`
+	TransactionCount int64                  `protobuf:"varint,5,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TagSummary) Reset() {
+	*x = TagSummary{}
+	mi := &file_master_master_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSummary) ProtoMessage() {}
+
+func (x *TagSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSummary.ProtoReflect.Descriptor instead.
+func (*TagSummary) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *TagSummary) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *TagSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagSummary) GetIncome() *common.Money {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+func (x *TagSummary) GetExpense() *common.Money {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *TagSummary) GetTransactionCount() int64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+type GetTagSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagSummaryRequest) Reset() {
+	*x = GetTagSummaryRequest{}
+	mi := &file_master_master_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagSummaryRequest) ProtoMessage() {}
+
+func (x *GetTagSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetTagSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetTagSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTagSummaryRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetTagSummaryRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetTagSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summaries     []*TagSummary          `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagSummaryResponse) Reset() {
+	*x = GetTagSummaryResponse{}
+	mi := &file_master_master_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagSummaryResponse) ProtoMessage() {}
+
+func (x *GetTagSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetTagSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetTagSummaryResponse) GetSummaries() []*TagSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+type GetBalanceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Interval      common.TimePeriod      `protobuf:"varint,2,opt,name=interval,proto3,enum=common.TimePeriod" json:"interval,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceHistoryRequest) Reset() {
+	*x = GetBalanceHistoryRequest{}
+	mi := &file_master_master_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceHistoryRequest) ProtoMessage() {}
+
+func (x *GetBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetBalanceHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBalanceHistoryRequest) GetInterval() common.TimePeriod {
+	if x != nil {
+		return x.Interval
+	}
+	return common.TimePeriod(0)
+}
+
+func (x *GetBalanceHistoryRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetBalanceHistoryRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetBalanceHistoryRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetBalanceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*BalanceHistoryPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceHistoryResponse) Reset() {
+	*x = GetBalanceHistoryResponse{}
+	mi := &file_master_master_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnalyticsResponse) String() string {
+func (x *GetBalanceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnalyticsResponse) ProtoMessage() {}
+func (*GetBalanceHistoryResponse) ProtoMessage() {}
 
-func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[7]
+func (x *GetBalanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,42 +8486,43 @@ func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
-func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetBalanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *GetAnalyticsResponse) GetStatistics() *analyzer.GetStatisticsResponse {
+func (x *GetBalanceHistoryResponse) GetPoints() []*BalanceHistoryPoint {
 	if x != nil {
-		return x.Statistics
+		return x.Points
 	}
 	return nil
 }
 
-type GetForecastRequest struct {
+type BalanceHistoryPoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Period        common.TimePeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
-	PeriodsAhead  int32                  `protobuf:"varint,3,opt,name=periods_ahead,json=periodsAhead,proto3" json:"periods_ahead,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	TotalBalance  *common.Money          `protobuf:"bytes,3,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	Accounts      []*AccountBalancePoint `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetForecastRequest) Reset() {
-	*x = GetForecastRequest{}
-	mi := &file_master_master_proto_msgTypes[8]
+func (x *BalanceHistoryPoint) Reset() {
+	*x = BalanceHistoryPoint{}
+	mi := &file_master_master_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForecastRequest) String() string {
+func (x *BalanceHistoryPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForecastRequest) ProtoMessage() {}
+func (*BalanceHistoryPoint) ProtoMessage() {}
 
-func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[8]
+func (x *BalanceHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,54 +8533,62 @@ func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
-func (*GetForecastRequest) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BalanceHistoryPoint.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *GetForecastRequest) GetUserId() string {
+func (x *BalanceHistoryPoint) GetPeriodStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.PeriodStart
 	}
-	return ""
+	return nil
 }
 
-func (x *GetForecastRequest) GetPeriod() common.TimePeriod {
+func (x *BalanceHistoryPoint) GetPeriodEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Period
+		return x.PeriodEnd
 	}
-	return common.TimePeriod(0)
+	return nil
 }
 
-func (x *GetForecastRequest) GetPeriodsAhead() int32 {
+func (x *BalanceHistoryPoint) GetTotalBalance() *common.Money {
 	if x != nil {
-		return x.PeriodsAhead
+		return x.TotalBalance
 	}
-	return 0
+	return nil
 }
 
-type GetForecastResponse struct {
+func (x *BalanceHistoryPoint) GetAccounts() []*AccountBalancePoint {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type AccountBalancePoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Forecasts     []*analyzer.Forecast   `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Balance       *common.Money          `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetForecastResponse) Reset() {
-	*x = GetForecastResponse{}
-	mi := &file_master_master_proto_msgTypes[9]
+func (x *AccountBalancePoint) Reset() {
+	*x = AccountBalancePoint{}
+	mi := &file_master_master_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForecastResponse) String() string {
+func (x *AccountBalancePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForecastResponse) ProtoMessage() {}
+func (*AccountBalancePoint) ProtoMessage() {}
 
-func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[9]
+func (x *AccountBalancePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,14 +8599,133 @@ func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
-func (*GetForecastResponse) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AccountBalancePoint.ProtoReflect.Descriptor instead.
+func (*AccountBalancePoint) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *GetForecastResponse) GetForecasts() []*analyzer.Forecast {
+func (x *AccountBalancePoint) GetAccountId() string {
 	if x != nil {
-		return x.Forecasts
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountBalancePoint) GetBalance() *common.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type GetBalanceAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceAtRequest) Reset() {
+	*x = GetBalanceAtRequest{}
+	mi := &file_master_master_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtRequest) ProtoMessage() {}
+
+func (x *GetBalanceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetBalanceAtRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBalanceAtRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GetBalanceAtRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetBalanceAtResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalBalance    *common.Money          `protobuf:"bytes,1,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	AccountBalances []*AccountBalance      `protobuf:"bytes,2,rep,name=account_balances,json=accountBalances,proto3" json:"account_balances,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetBalanceAtResponse) Reset() {
+	*x = GetBalanceAtResponse{}
+	mi := &file_master_master_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtResponse) ProtoMessage() {}
+
+func (x *GetBalanceAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetBalanceAtResponse) GetTotalBalance() *common.Money {
+	if x != nil {
+		return x.TotalBalance
+	}
+	return nil
+}
+
+func (x *GetBalanceAtResponse) GetAccountBalances() []*AccountBalance {
+	if x != nil {
+		return x.AccountBalances
 	}
 	return nil
 }
@@ -566,7 +8734,7 @@ var File_master_master_proto protoreflect.FileDescriptor
 
 const file_master_master_proto_rawDesc = "" +
 	"\n" +
-	"\x13master/master.proto\x12\x06master\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13common/common.proto\x1a\x13wallet/wallet.proto\x1a\x17analyzer/analyzer.proto\"\xc6\x02\n" +
+	"\x13master/master.proto\x12\x06master\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13common/common.proto\x1a\x13wallet/wallet.proto\x1a\x17analyzer/analyzer.proto\x1a\x13market/market.proto\"\xf2\x02\n" +
 	"\x18CreateTransactionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x17.common.TransactionTypeR\x04type\x12%\n" +
@@ -576,23 +8744,101 @@ const file_master_master_proto_rawDesc = "" +
 	"\x0ffrom_account_id\x18\x05 \x01(\tR\rfromAccountId\x12\"\n" +
 	"\rto_account_id\x18\x06 \x01(\tR\vtoAccountId\x12.\n" +
 	"\x04date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12 \n" +
-	"\vdescription\x18\b \x01(\tR\vdescription\"R\n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12*\n" +
+	"\tto_amount\x18\t \x01(\v2\r.common.MoneyR\btoAmount\"R\n" +
 	"\x19CreateTransactionResponse\x125\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x13.wallet.TransactionR\vtransaction\"1\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x13.wallet.TransactionR\vtransaction\"\x99\x03\n" +
+	"\x18UpdateTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x17.common.TransactionTypeR\x04type\x12%\n" +
+	"\x06amount\x18\x04 \x01(\v2\r.common.MoneyR\x06amount\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\x12&\n" +
+	"\x0ffrom_account_id\x18\x06 \x01(\tR\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\a \x01(\tR\vtoAccountId\x12.\n" +
+	"\x04date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12*\n" +
+	"\tto_amount\x18\n" +
+	" \x01(\v2\r.common.MoneyR\btoAmount\"R\n" +
+	"\x19UpdateTransactionResponse\x125\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x13.wallet.TransactionR\vtransaction\"Z\n" +
+	"\x18DeleteTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1b\n" +
+	"\x19DeleteTransactionResponse\"\xec\x03\n" +
 	"\x16GetTransactionsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1f\n" +
+	"\vaccount_ids\x18\x06 \x03(\tR\n" +
+	"accountIds\x12+\n" +
+	"\x04type\x18\a \x01(\x0e2\x17.common.TransactionTypeR\x04type\x12!\n" +
+	"\fcategory_ids\x18\b \x03(\tR\vcategoryIds\x12\"\n" +
+	"\n" +
+	"min_amount\x18\t \x01(\x03H\x00R\tminAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_amount\x18\n" +
+	" \x01(\x03H\x01R\tmaxAmount\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tagsB\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amount\"z\n" +
 	"\x17GetTransactionsResponse\x127\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x13.wallet.TransactionR\ftransactions\",\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x13.wallet.TransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"H\n" +
 	"\x11GetBalanceRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"u\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xb8\x01\n" +
 	"\x12GetBalanceResponse\x122\n" +
 	"\rtotal_balance\x18\x01 \x01(\v2\r.common.MoneyR\ftotalBalance\x12+\n" +
-	"\baccounts\x18\x02 \x03(\v2\x0f.wallet.AccountR\baccounts\"\xa0\x01\n" +
+	"\baccounts\x18\x02 \x03(\v2\x0f.wallet.AccountR\baccounts\x12A\n" +
+	"\x10account_balances\x18\x03 \x03(\v2\x16.master.AccountBalanceR\x0faccountBalances\"\x84\x02\n" +
+	"\x0eAccountBalance\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12'\n" +
+	"\abalance\x18\x02 \x01(\v2\r.common.MoneyR\abalance\x12:\n" +
+	"\x11converted_balance\x18\x03 \x01(\v2\r.common.MoneyR\x10convertedBalance\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x127\n" +
+	"\trate_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\brateDate\x12!\n" +
+	"\frate_missing\x18\x06 \x01(\bR\vrateMissing\"\xa4\x01\n" +
+	"\x14CreateAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x13.common.AccountTypeR\x04type\x126\n" +
+	"\x0finitial_balance\x18\x04 \x01(\v2\r.common.MoneyR\x0einitialBalance\"B\n" +
+	"\x15CreateAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.wallet.AccountR\aaccount\"b\n" +
+	"\x14UpdateAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"B\n" +
+	"\x15UpdateAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.wallet.AccountR\aaccount\"k\n" +
+	"\x15ArchiveAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\barchived\x18\x03 \x01(\bR\barchived\"C\n" +
+	"\x16ArchiveAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.wallet.AccountR\aaccount\"\x83\x01\n" +
+	"\x14DeleteAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x123\n" +
+	"\x16reassign_to_account_id\x18\x03 \x01(\tR\x13reassignToAccountId\"\x17\n" +
+	"\x15DeleteAccountResponse\"\xcf\x01\n" +
 	"\x13GetAnalyticsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"W\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12-\n" +
+	"\bgroup_by\x18\x04 \x01(\x0e2\x12.common.TimePeriodR\agroupBy\"W\n" +
 	"\x14GetAnalyticsResponse\x12?\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2\x1f.analyzer.GetStatisticsResponseR\n" +
@@ -602,15 +8848,624 @@ const file_master_master_proto_rawDesc = "" +
 	"\x06period\x18\x02 \x01(\x0e2\x12.common.TimePeriodR\x06period\x12#\n" +
 	"\rperiods_ahead\x18\x03 \x01(\x05R\fperiodsAhead\"G\n" +
 	"\x13GetForecastResponse\x120\n" +
-	"\tforecasts\x18\x01 \x03(\v2\x12.analyzer.ForecastR\tforecasts2\xa5\x04\n" +
+	"\tforecasts\x18\x01 \x03(\v2\x12.analyzer.ForecastR\tforecasts\"W\n" +
+	"\x1dGetInvestmentPositionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"Z\n" +
+	"\x1eGetInvestmentPositionsResponse\x128\n" +
+	"\tpositions\x18\x01 \x03(\v2\x1a.market.InvestmentPositionR\tpositions\"(\n" +
+	"\x12GetSecurityRequest\x12\x12\n" +
+	"\x04figi\x18\x01 \x01(\tR\x04figi\"C\n" +
+	"\x13GetSecurityResponse\x12,\n" +
+	"\bsecurity\x18\x01 \x01(\v2\x10.market.SecurityR\bsecurity\"2\n" +
+	"\x1aGetSecuritiesPricesRequest\x12\x14\n" +
+	"\x05figis\x18\x01 \x03(\tR\x05figis\"O\n" +
+	"\x1bGetSecuritiesPricesResponse\x120\n" +
+	"\n" +
+	"securities\x18\x01 \x03(\v2\x10.market.SecurityR\n" +
+	"securities\"\xa4\x01\n" +
+	"\x1aGetSecurityPaymentsRequest\x12\x14\n" +
+	"\x05figis\x18\x01 \x03(\tR\x05figis\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"R\n" +
+	"\x1bGetSecurityPaymentsResponse\x123\n" +
+	"\bpayments\x18\x01 \x03(\v2\x17.market.SecurityPaymentR\bpayments\"\xf4\x01\n" +
+	"\n" +
+	"BrokerLink\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
+	"\fbackend_type\x18\x02 \x01(\tR\vbackendType\x12.\n" +
+	"\x13external_account_id\x18\x03 \x01(\tR\x11externalAccountId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb4\x01\n" +
+	"\x11LinkBrokerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12!\n" +
+	"\fbackend_type\x18\x03 \x01(\tR\vbackendType\x12.\n" +
+	"\x13external_account_id\x18\x04 \x01(\tR\x11externalAccountId\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\"<\n" +
+	"\x12LinkBrokerResponse\x12&\n" +
+	"\x04link\x18\x01 \x01(\v2\x12.master.BrokerLinkR\x04link\"M\n" +
+	"\x13UnlinkBrokerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"\x16\n" +
+	"\x14UnlinkBrokerResponse\"I\n" +
+	"\x12GetNetWorthRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\x91\x03\n" +
+	"\x13GetNetWorthResponse\x12#\n" +
+	"\x05total\x18\x01 \x01(\v2\r.common.MoneyR\x05total\x12,\n" +
+	"\n" +
+	"cash_total\x18\x02 \x01(\v2\r.common.MoneyR\tcashTotal\x12:\n" +
+	"\x11investments_total\x18\x03 \x01(\v2\r.common.MoneyR\x10investmentsTotal\x123\n" +
+	"\baccounts\x18\x04 \x03(\v2\x17.master.NetWorthAccountR\baccounts\x128\n" +
+	"\n" +
+	"securities\x18\x05 \x03(\v2\x18.master.NetWorthSecurityR\n" +
+	"securities\x12C\n" +
+	"\x0esecurity_types\x18\x06 \x03(\v2\x1c.master.NetWorthSecurityTypeR\rsecurityTypes\x127\n" +
+	"\tvalued_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bvaluedAt\"\xee\x01\n" +
+	"\x0fNetWorthAccount\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x13.common.AccountTypeR\x04type\x12#\n" +
+	"\x05value\x18\x04 \x01(\v2\r.common.MoneyR\x05value\x127\n" +
+	"\tvalued_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bvaluedAt\x12!\n" +
+	"\frate_missing\x18\x06 \x01(\bR\vrateMissing\"\xfa\x01\n" +
+	"\x10NetWorthSecurity\x12\x12\n" +
+	"\x04figi\x18\x01 \x01(\tR\x04figi\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12#\n" +
+	"\x05price\x18\x05 \x01(\v2\r.common.MoneyR\x05price\x12#\n" +
+	"\x05value\x18\x06 \x01(\v2\r.common.MoneyR\x05value\x12D\n" +
+	"\x10price_updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0epriceUpdatedAt\"O\n" +
+	"\x14NetWorthSecurityType\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12#\n" +
+	"\x05value\x18\x02 \x01(\v2\r.common.MoneyR\x05value\"Z\n" +
+	"\x13GetAnomaliesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x12.common.TimePeriodR\x06period\"O\n" +
+	"\x14GetAnomaliesResponse\x127\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x19.analyzer.CategoryAnomalyR\tanomalies\"6\n" +
+	"\x1bGetUpcomingRecurringRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"V\n" +
+	"\x1cGetUpcomingRecurringResponse\x126\n" +
+	"\bpayments\x18\x01 \x03(\v2\x1a.analyzer.RecurringPaymentR\bpayments\"\xd1\x02\n" +
+	"\fNotification\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\asent_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x123\n" +
+	"\aread_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\x12\x12\n" +
+	"\x04read\x18\b \x01(\bR\x04read\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"\x90\x01\n" +
+	"\x18ListNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vunread_only\x18\x04 \x01(\bR\n" +
+	"unreadOnly\"\x7f\n" +
+	"\x19ListNotificationsResponse\x12:\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x14.master.NotificationR\rnotifications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"v\n" +
+	"\x1cMarkNotificationsReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
+	"\x10notification_ids\x18\x02 \x03(\tR\x0fnotificationIds\x12\x12\n" +
+	"\x04read\x18\x03 \x01(\bR\x04read\"D\n" +
+	"\x1dMarkNotificationsReadResponse\x12#\n" +
+	"\rupdated_count\x18\x01 \x01(\x03R\fupdatedCount\"]\n" +
+	"\x19DeleteNotificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\tR\x0enotificationId\"\x1c\n" +
+	"\x1aDeleteNotificationResponse\"=\n" +
+	"\"GetUnreadNotificationsCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
+	"#GetUnreadNotificationsCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"\xeb\x01\n" +
+	"\x06Budget\x12\x1b\n" +
+	"\tbudget_id\x18\x01 \x01(\tR\bbudgetId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12*\n" +
+	"\x06period\x18\x04 \x01(\x0e2\x12.common.TimePeriodR\x06period\x12#\n" +
+	"\x05limit\x18\x05 \x01(\v2\r.common.MoneyR\x05limit\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa5\x02\n" +
+	"\fBudgetStatus\x12&\n" +
+	"\x06budget\x18\x01 \x01(\v2\x0e.master.BudgetR\x06budget\x12#\n" +
+	"\x05spent\x18\x02 \x01(\v2\r.common.MoneyR\x05spent\x12+\n" +
+	"\tremaining\x18\x03 \x01(\v2\r.common.MoneyR\tremaining\x12!\n" +
+	"\fused_percent\x18\x04 \x01(\x01R\vusedPercent\x12=\n" +
+	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\"\xa0\x01\n" +
+	"\x13CreateBudgetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12*\n" +
+	"\x06period\x18\x03 \x01(\x0e2\x12.common.TimePeriodR\x06period\x12#\n" +
+	"\x05limit\x18\x04 \x01(\v2\r.common.MoneyR\x05limit\">\n" +
+	"\x14CreateBudgetResponse\x12&\n" +
+	"\x06budget\x18\x01 \x01(\v2\x0e.master.BudgetR\x06budget\"p\n" +
+	"\x13UpdateBudgetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tbudget_id\x18\x02 \x01(\tR\bbudgetId\x12#\n" +
+	"\x05limit\x18\x03 \x01(\v2\r.common.MoneyR\x05limit\">\n" +
+	"\x14UpdateBudgetResponse\x12&\n" +
+	"\x06budget\x18\x01 \x01(\v2\x0e.master.BudgetR\x06budget\"K\n" +
+	"\x13DeleteBudgetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tbudget_id\x18\x02 \x01(\tR\bbudgetId\"\x16\n" +
+	"\x14DeleteBudgetResponse\"-\n" +
+	"\x12ListBudgetsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"?\n" +
+	"\x13ListBudgetsResponse\x12(\n" +
+	"\abudgets\x18\x01 \x03(\v2\x0e.master.BudgetR\abudgets\"a\n" +
+	"\x16GetBudgetStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"K\n" +
+	"\x17GetBudgetStatusResponse\x120\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x14.master.BudgetStatusR\bstatuses\"\x85\x02\n" +
+	"\x04Goal\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\tR\x06goalId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\x06target\x18\x04 \x01(\v2\r.common.MoneyR\x06target\x126\n" +
+	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\tR\taccountId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xda\x02\n" +
+	"\fGoalProgress\x12 \n" +
+	"\x04goal\x18\x01 \x01(\v2\f.master.GoalR\x04goal\x12'\n" +
+	"\acurrent\x18\x02 \x01(\v2\r.common.MoneyR\acurrent\x12+\n" +
+	"\tremaining\x18\x03 \x01(\v2\r.common.MoneyR\tremaining\x12)\n" +
+	"\x10progress_percent\x18\x04 \x01(\x01R\x0fprogressPercent\x12M\n" +
+	"\x14projected_completion\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x13projectedCompletion\x12\x19\n" +
+	"\bon_track\x18\x06 \x01(\bR\aonTrack\x12=\n" +
+	"\raccount_state\x18\a \x01(\x0e2\x18.master.GoalAccountStateR\faccountState\"\xbe\x01\n" +
+	"\x11CreateGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x06target\x18\x03 \x01(\v2\r.common.MoneyR\x06target\x126\n" +
+	"\bdeadline\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\tR\taccountId\"6\n" +
+	"\x12CreateGoalResponse\x12 \n" +
+	"\x04goal\x18\x01 \x01(\v2\f.master.GoalR\x04goal\"\xd7\x01\n" +
+	"\x11UpdateGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\tR\x06goalId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\x06target\x18\x04 \x01(\v2\r.common.MoneyR\x06target\x126\n" +
+	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\tR\taccountId\"6\n" +
+	"\x12UpdateGoalResponse\x12 \n" +
+	"\x04goal\x18\x01 \x01(\v2\f.master.GoalR\x04goal\"E\n" +
+	"\x11DeleteGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\tR\x06goalId\"\x14\n" +
+	"\x12DeleteGoalResponse\"*\n" +
+	"\x0fGetGoalsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\">\n" +
+	"\x10GetGoalsResponse\x12*\n" +
+	"\x05goals\x18\x01 \x03(\v2\x14.master.GoalProgressR\x05goals\"u\n" +
+	"\x1aAddGoalContributionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\tR\x06goalId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"\x1d\n" +
+	"\x1bAddGoalContributionResponse\"x\n" +
+	"\x1dRemoveGoalContributionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\tR\x06goalId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\" \n" +
+	"\x1eRemoveGoalContributionResponse\"\xb7\x01\n" +
+	"\bCategory\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04icon\x18\x05 \x01(\tR\x04icon\x12\x14\n" +
+	"\x05color\x18\x06 \x01(\tR\x05color\x12\x16\n" +
+	"\x06system\x18\a \x01(\bR\x06system\"0\n" +
+	"\x15ListCategoriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"J\n" +
+	"\x16ListCategoriesResponse\x120\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x10.master.CategoryR\n" +
+	"categories\"\x8b\x01\n" +
+	"\x15CreateCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\tR\x05color\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\tR\bparentId\"F\n" +
+	"\x16CreateCategoryResponse\x12,\n" +
+	"\bcategory\x18\x01 \x01(\v2\x10.master.CategoryR\bcategory\"\xac\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12\x14\n" +
+	"\x05color\x18\x05 \x01(\tR\x05color\x12\x1b\n" +
+	"\tparent_id\x18\x06 \x01(\tR\bparentId\"F\n" +
+	"\x16UpdateCategoryResponse\x12,\n" +
+	"\bcategory\x18\x01 \x01(\v2\x10.master.CategoryR\bcategory\"Q\n" +
+	"\x15DeleteCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\"\x18\n" +
+	"\x16DeleteCategoryResponse\"\x8c\x04\n" +
+	"\x0fTransactionRule\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bH\x00R\aenabled\x88\x01\x01\x121\n" +
+	"\x14description_contains\x18\x06 \x01(\tR\x13descriptionContains\x12+\n" +
+	"\x11description_regex\x18\a \x01(\tR\x10descriptionRegex\x12\"\n" +
+	"\n" +
+	"min_amount\x18\b \x01(\x03H\x01R\tminAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_amount\x18\t \x01(\x03H\x02R\tmaxAmount\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\n" +
+	" \x01(\tR\taccountId\x12\x15\n" +
+	"\x03mcc\x18\v \x01(\x05H\x03R\x03mcc\x88\x01\x01\x12&\n" +
+	"\x0fset_category_id\x18\f \x01(\tR\rsetCategoryId\x12'\n" +
+	"\x0fset_description\x18\r \x01(\tR\x0esetDescription\x12\x17\n" +
+	"\aset_tag\x18\x0e \x01(\tR\x06setTagB\n" +
+	"\n" +
+	"\b_enabledB\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amountB\x06\n" +
+	"\x04_mcc\"+\n" +
+	"\x10ListRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"B\n" +
+	"\x11ListRulesResponse\x12-\n" +
+	"\x05rules\x18\x01 \x03(\v2\x17.master.TransactionRuleR\x05rules\"Y\n" +
+	"\x11CreateRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x04rule\x18\x02 \x01(\v2\x17.master.TransactionRuleR\x04rule\"A\n" +
+	"\x12CreateRuleResponse\x12+\n" +
+	"\x04rule\x18\x01 \x01(\v2\x17.master.TransactionRuleR\x04rule\"r\n" +
+	"\x11UpdateRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12+\n" +
+	"\x04rule\x18\x03 \x01(\v2\x17.master.TransactionRuleR\x04rule\"A\n" +
+	"\x12UpdateRuleResponse\x12+\n" +
+	"\x04rule\x18\x01 \x01(\v2\x17.master.TransactionRuleR\x04rule\"E\n" +
+	"\x11DeleteRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\"\x14\n" +
+	"\x12DeleteRuleResponse\"I\n" +
+	"\x13ReorderRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\brule_ids\x18\x02 \x03(\tR\aruleIds\"E\n" +
+	"\x14ReorderRulesResponse\x12-\n" +
+	"\x05rules\x18\x01 \x03(\v2\x17.master.TransactionRuleR\x05rules\"\x9a\x01\n" +
+	"\n" +
+	"RuleChange\x125\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x13.wallet.TransactionR\vtransaction\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\"o\n" +
+	"\x11DryRunRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x04rule\x18\x02 \x01(\v2\x17.master.TransactionRuleR\x04rule\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"`\n" +
+	"\x12DryRunRuleResponse\x12,\n" +
+	"\achanges\x18\x01 \x03(\v2\x12.master.RuleChangeR\achanges\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\",\n" +
+	"\x11ApplyRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"9\n" +
+	"\x12ApplyRulesResponse\x12#\n" +
+	"\rupdated_count\x18\x01 \x01(\x03R\fupdatedCount\"\xff\x04\n" +
+	"\rImportProfile\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\tR\tprofileId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tdelimiter\x18\x04 \x01(\tR\tdelimiter\x12\x1a\n" +
+	"\bencoding\x18\x05 \x01(\tR\bencoding\x12\x1f\n" +
+	"\vdate_format\x18\x06 \x01(\tR\n" +
+	"dateFormat\x12+\n" +
+	"\x11decimal_separator\x18\a \x01(\tR\x10decimalSeparator\x12\x1b\n" +
+	"\tskip_rows\x18\b \x01(\x05R\bskipRows\x12\"\n" +
+	"\n" +
+	"has_header\x18\t \x01(\bH\x00R\thasHeader\x88\x01\x01\x12E\n" +
+	"\x0fsign_convention\x18\n" +
+	" \x01(\x0e2\x1c.master.ImportSignConventionR\x0esignConvention\x12\x1f\n" +
+	"\vdate_column\x18\v \x01(\tR\n" +
+	"dateColumn\x12#\n" +
+	"\ramount_column\x18\f \x01(\tR\famountColumn\x12!\n" +
+	"\fdebit_column\x18\r \x01(\tR\vdebitColumn\x12#\n" +
+	"\rcredit_column\x18\x0e \x01(\tR\fcreditColumn\x12-\n" +
+	"\x12description_column\x18\x0f \x01(\tR\x11descriptionColumn\x12\x1d\n" +
+	"\n" +
+	"mcc_column\x18\x10 \x01(\tR\tmccColumn\x12'\n" +
+	"\x0fcurrency_column\x18\x11 \x01(\tR\x0ecurrencyColumnB\r\n" +
+	"\v_has_header\"4\n" +
+	"\x19ListImportProfilesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"O\n" +
+	"\x1aListImportProfilesResponse\x121\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x15.master.ImportProfileR\bprofiles\"f\n" +
+	"\x1aCreateImportProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\aprofile\x18\x02 \x01(\v2\x15.master.ImportProfileR\aprofile\"N\n" +
+	"\x1bCreateImportProfileResponse\x12/\n" +
+	"\aprofile\x18\x01 \x01(\v2\x15.master.ImportProfileR\aprofile\"\x85\x01\n" +
+	"\x1aUpdateImportProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\tR\tprofileId\x12/\n" +
+	"\aprofile\x18\x03 \x01(\v2\x15.master.ImportProfileR\aprofile\"N\n" +
+	"\x1bUpdateImportProfileResponse\x12/\n" +
+	"\aprofile\x18\x01 \x01(\v2\x15.master.ImportProfileR\aprofile\"T\n" +
+	"\x1aDeleteImportProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\tR\tprofileId\"\x1d\n" +
+	"\x1bDeleteImportProfileResponse\"\xa5\x01\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.master.ImportRowStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x125\n" +
+	"\vtransaction\x18\x04 \x01(\v2\x13.wallet.TransactionR\vtransaction\"\xb4\x02\n" +
+	"\x19ImportTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x03 \x01(\tR\tprofileId\x12/\n" +
+	"\aprofile\x18\x04 \x01(\v2\x15.master.ImportProfileR\aprofile\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12,\n" +
+	"\x06format\x18\a \x01(\x0e2\x14.master.ImportFormatR\x06format\x12.\n" +
+	"\x13transfer_account_id\x18\b \x01(\tR\x11transferAccountId\"\xb6\x01\n" +
+	"\x1aImportTransactionsResponse\x12+\n" +
+	"\x04rows\x18\x01 \x03(\v2\x17.master.ImportRowResultR\x04rows\x12%\n" +
+	"\x0eaccepted_count\x18\x02 \x01(\x05R\racceptedCount\x12#\n" +
+	"\rskipped_count\x18\x03 \x01(\x05R\fskippedCount\x12\x1f\n" +
+	"\verror_count\x18\x04 \x01(\x05R\n" +
+	"errorCount\"\xdb\x05\n" +
+	"\x14RecurringTransaction\x12!\n" +
+	"\frecurring_id\x18\x01 \x01(\tR\vrecurringId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\"\n" +
+	"\rto_account_id\x18\x04 \x01(\tR\vtoAccountId\x12+\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x17.common.TransactionTypeR\x04type\x12%\n" +
+	"\x06amount\x18\x06 \x01(\v2\r.common.MoneyR\x06amount\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\tR\n" +
+	"categoryId\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x129\n" +
+	"\tfrequency\x18\t \x01(\x0e2\x1b.master.RecurrenceFrequencyR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\n" +
+	" \x01(\x05R\binterval\x12 \n" +
+	"\fday_of_month\x18\v \x01(\x05R\n" +
+	"dayOfMonth\x129\n" +
+	"\n" +
+	"start_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x14\n" +
+	"\x05count\x18\x0e \x01(\x05R\x05count\x12:\n" +
+	"\vnext_run_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12\x16\n" +
+	"\x06paused\x18\x10 \x01(\bR\x06paused\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x11 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\";\n" +
+	" ListRecurringTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"_\n" +
+	"!ListRecurringTransactionsResponse\x12:\n" +
+	"\trecurring\x18\x01 \x03(\v2\x1c.master.RecurringTransactionR\trecurring\"x\n" +
+	"!CreateRecurringTransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12:\n" +
+	"\trecurring\x18\x02 \x01(\v2\x1c.master.RecurringTransactionR\trecurring\"`\n" +
+	"\"CreateRecurringTransactionResponse\x12:\n" +
+	"\trecurring\x18\x01 \x01(\v2\x1c.master.RecurringTransactionR\trecurring\"v\n" +
+	" PauseRecurringTransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\frecurring_id\x18\x02 \x01(\tR\vrecurringId\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\"_\n" +
+	"!PauseRecurringTransactionResponse\x12:\n" +
+	"\trecurring\x18\x01 \x01(\v2\x1c.master.RecurringTransactionR\trecurring\"]\n" +
+	"\x1fSkipRecurringTransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\frecurring_id\x18\x02 \x01(\tR\vrecurringId\"^\n" +
+	" SkipRecurringTransactionResponse\x12:\n" +
+	"\trecurring\x18\x01 \x01(\v2\x1c.master.RecurringTransactionR\trecurring\"_\n" +
+	"!DeleteRecurringTransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\frecurring_id\x18\x02 \x01(\tR\vrecurringId\"$\n" +
+	"\"DeleteRecurringTransactionResponse\"\x8f\x01\n" +
+	"\x1bSetTransactionSplitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x120\n" +
+	"\x06splits\x18\x03 \x03(\v2\x18.wallet.TransactionSplitR\x06splits\"U\n" +
+	"\x1cSetTransactionSplitsResponse\x125\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x13.wallet.TransactionR\vtransaction\"\xa5\x01\n" +
+	"\x03Tag\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vusage_count\x18\x04 \x01(\x03R\n" +
+	"usageCount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"*\n" +
+	"\x0fListTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"3\n" +
+	"\x10ListTagsResponse\x12\x1f\n" +
+	"\x04tags\x18\x01 \x03(\v2\v.master.TagR\x04tags\"o\n" +
+	"\x19AddTransactionTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"0\n" +
+	"\x1aAddTransactionTagsResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"r\n" +
+	"\x1cRemoveTransactionTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"3\n" +
+	"\x1dRemoveTransactionTagsResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"\xb4\x01\n" +
+	"\n" +
+	"TagSummary\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x06income\x18\x03 \x01(\v2\r.common.MoneyR\x06income\x12'\n" +
+	"\aexpense\x18\x04 \x01(\v2\r.common.MoneyR\aexpense\x12+\n" +
+	"\x11transaction_count\x18\x05 \x01(\x03R\x10transactionCount\"\xa1\x01\n" +
+	"\x14GetTagSummaryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"I\n" +
+	"\x15GetTagSummaryResponse\x120\n" +
+	"\tsummaries\x18\x01 \x03(\v2\x12.master.TagSummaryR\tsummaries\"\xf1\x01\n" +
+	"\x18GetBalanceHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\binterval\x18\x02 \x01(\x0e2\x12.common.TimePeriodR\binterval\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"P\n" +
+	"\x19GetBalanceHistoryResponse\x123\n" +
+	"\x06points\x18\x01 \x03(\v2\x1b.master.BalanceHistoryPointR\x06points\"\xfc\x01\n" +
+	"\x13BalanceHistoryPoint\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x122\n" +
+	"\rtotal_balance\x18\x03 \x01(\v2\r.common.MoneyR\ftotalBalance\x127\n" +
+	"\baccounts\x18\x04 \x03(\v2\x1b.master.AccountBalancePointR\baccounts\"]\n" +
+	"\x13AccountBalancePoint\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12'\n" +
+	"\abalance\x18\x02 \x01(\v2\r.common.MoneyR\abalance\"z\n" +
+	"\x13GetBalanceAtRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\x8d\x01\n" +
+	"\x14GetBalanceAtResponse\x122\n" +
+	"\rtotal_balance\x18\x01 \x01(\v2\r.common.MoneyR\ftotalBalance\x12A\n" +
+	"\x10account_balances\x18\x02 \x03(\v2\x16.master.AccountBalanceR\x0faccountBalances*\x96\x01\n" +
+	"\x10GoalAccountState\x12\"\n" +
+	"\x1eGOAL_ACCOUNT_STATE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19GOAL_ACCOUNT_STATE_ACTIVE\x10\x01\x12\x1f\n" +
+	"\x1bGOAL_ACCOUNT_STATE_ARCHIVED\x10\x02\x12\x1e\n" +
+	"\x1aGOAL_ACCOUNT_STATE_DELETED\x10\x03*\xc1\x01\n" +
+	"\x14ImportSignConvention\x12&\n" +
+	"\"IMPORT_SIGN_CONVENTION_UNSPECIFIED\x10\x00\x12+\n" +
+	"'IMPORT_SIGN_CONVENTION_NEGATIVE_EXPENSE\x10\x01\x12+\n" +
+	"'IMPORT_SIGN_CONVENTION_POSITIVE_EXPENSE\x10\x02\x12'\n" +
+	"#IMPORT_SIGN_CONVENTION_DEBIT_CREDIT\x10\x03*\xbf\x01\n" +
+	"\x13RecurrenceFrequency\x12$\n" +
+	" RECURRENCE_FREQUENCY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRECURRENCE_FREQUENCY_DAILY\x10\x01\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_WEEKLY\x10\x02\x12 \n" +
+	"\x1cRECURRENCE_FREQUENCY_MONTHLY\x10\x03\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_YEARLY\x10\x04*r\n" +
+	"\fImportFormat\x12\x1d\n" +
+	"\x19IMPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMPORT_FORMAT_CSV\x10\x01\x12\x15\n" +
+	"\x11IMPORT_FORMAT_OFX\x10\x02\x12\x15\n" +
+	"\x11IMPORT_FORMAT_QIF\x10\x03*\x90\x01\n" +
+	"\x0fImportRowStatus\x12!\n" +
+	"\x1dIMPORT_ROW_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aIMPORT_ROW_STATUS_ACCEPTED\x10\x01\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_SKIPPED\x10\x02\x12\x1b\n" +
+	"\x17IMPORT_ROW_STATUS_ERROR\x10\x032\xf0<\n" +
 	"\rMasterService\x12r\n" +
-	"\x11CreateTransaction\x12 .master.CreateTransactionRequest\x1a!.master.CreateTransactionResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/transactions\x12y\n" +
+	"\x11CreateTransaction\x12 .master.CreateTransactionRequest\x1a!.master.CreateTransactionResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/transactions\x12\x83\x01\n" +
+	"\x11UpdateTransaction\x12 .master.UpdateTransactionRequest\x1a!.master.UpdateTransactionResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/transactions/{transaction_id}\x12\x90\x01\n" +
+	"\x11DeleteTransaction\x12 .master.DeleteTransactionRequest\x1a!.master.DeleteTransactionResponse\"6\x82\xd3\xe4\x93\x020*./users/{user_id}/transactions/{transaction_id}\x12y\n" +
 	"\x0fGetTransactions\x12\x1e.master.GetTransactionsRequest\x1a\x1f.master.GetTransactionsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/users/{user_id}/transactions\x12e\n" +
 	"\n" +
-	"GetBalance\x12\x19.master.GetBalanceRequest\x1a\x1a.master.GetBalanceResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/users/{user_id}/balance\x12`\n" +
+	"GetBalance\x12\x19.master.GetBalanceRequest\x1a\x1a.master.GetBalanceResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/users/{user_id}/balance\x12b\n" +
+	"\rCreateAccount\x12\x1c.master.CreateAccountRequest\x1a\x1d.master.CreateAccountResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/accounts\x12o\n" +
+	"\rUpdateAccount\x12\x1c.master.UpdateAccountRequest\x1a\x1d.master.UpdateAccountResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/accounts/{account_id}\x12z\n" +
+	"\x0eArchiveAccount\x12\x1d.master.ArchiveAccountRequest\x1a\x1e.master.ArchiveAccountResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/accounts/{account_id}/archive\x12|\n" +
+	"\rDeleteAccount\x12\x1c.master.DeleteAccountRequest\x1a\x1d.master.DeleteAccountResponse\".\x82\xd3\xe4\x93\x02(*&/users/{user_id}/accounts/{account_id}\x12`\n" +
 	"\fGetAnalytics\x12\x1b.master.GetAnalyticsRequest\x1a\x1c.master.GetAnalyticsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/analytics\x12\\\n" +
-	"\vGetForecast\x12\x1a.master.GetForecastRequest\x1a\x1b.master.GetForecastResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/forecastB\x7f\n" +
+	"\vGetForecast\x12\x1a.master.GetForecastRequest\x1a\x1b.master.GetForecastResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/forecast\x12\xa1\x01\n" +
+	"\x16GetInvestmentPositions\x12%.master.GetInvestmentPositionsRequest\x1a&.master.GetInvestmentPositionsResponse\"8\x82\xd3\xe4\x93\x022\x120/users/{user_id}/accounts/{account_id}/positions\x12b\n" +
+	"\vGetSecurity\x12\x1a.master.GetSecurityRequest\x1a\x1b.master.GetSecurityResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/securities/{figi}\x12}\n" +
+	"\x13GetSecuritiesPrices\x12\".master.GetSecuritiesPricesRequest\x1a#.master.GetSecuritiesPricesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/securities/prices\x12\x7f\n" +
+	"\x13GetSecurityPayments\x12\".master.GetSecurityPaymentsRequest\x1a#.master.GetSecurityPaymentsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/securities/payments\x12m\n" +
+	"\n" +
+	"LinkBroker\x12\x19.master.LinkBrokerRequest\x1a\x1a.master.LinkBrokerResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/accounts/{account_id}/broker\x12\x80\x01\n" +
+	"\fUnlinkBroker\x12\x1b.master.UnlinkBrokerRequest\x1a\x1c.master.UnlinkBrokerResponse\"5\x82\xd3\xe4\x93\x02/*-/users/{user_id}/accounts/{account_id}/broker\x12j\n" +
+	"\vGetNetWorth\x12\x1a.master.GetNetWorthRequest\x1a\x1b.master.GetNetWorthResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/{user_id}/net-worth\x12m\n" +
+	"\fGetAnomalies\x12\x1b.master.GetAnomaliesRequest\x1a\x1c.master.GetAnomaliesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/{user_id}/anomalies\x12\x8d\x01\n" +
+	"\x14GetUpcomingRecurring\x12#.master.GetUpcomingRecurringRequest\x1a$.master.GetUpcomingRecurringResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/users/{user_id}/upcoming-payments\x12\x80\x01\n" +
+	"\x11ListNotifications\x12 .master.ListNotificationsRequest\x1a!.master.ListNotificationsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/users/{user_id}/notifications\x12\x84\x01\n" +
+	"\x15MarkNotificationsRead\x12$.master.MarkNotificationsReadRequest\x1a%.master.MarkNotificationsReadResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/notifications/read\x12\x95\x01\n" +
+	"\x12DeleteNotification\x12!.master.DeleteNotificationRequest\x1a\".master.DeleteNotificationResponse\"8\x82\xd3\xe4\x93\x022*0/users/{user_id}/notifications/{notification_id}\x12\xab\x01\n" +
+	"\x1bGetUnreadNotificationsCount\x12*.master.GetUnreadNotificationsCountRequest\x1a+.master.GetUnreadNotificationsCountResponse\"3\x82\xd3\xe4\x93\x02-\x12+/users/{user_id}/notifications/unread-count\x12^\n" +
+	"\fCreateBudget\x12\x1b.master.CreateBudgetRequest\x1a\x1c.master.CreateBudgetResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/budgets\x12j\n" +
+	"\fUpdateBudget\x12\x1b.master.UpdateBudgetRequest\x1a\x1c.master.UpdateBudgetResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*2\x14/budgets/{budget_id}\x12w\n" +
+	"\fDeleteBudget\x12\x1b.master.DeleteBudgetRequest\x1a\x1c.master.DeleteBudgetResponse\",\x82\xd3\xe4\x93\x02&*$/users/{user_id}/budgets/{budget_id}\x12h\n" +
+	"\vListBudgets\x12\x1a.master.ListBudgetsRequest\x1a\x1b.master.ListBudgetsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/users/{user_id}/budgets\x12{\n" +
+	"\x0fGetBudgetStatus\x12\x1e.master.GetBudgetStatusRequest\x1a\x1f.master.GetBudgetStatusResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/users/{user_id}/budgets/status\x12V\n" +
+	"\n" +
+	"CreateGoal\x12\x19.master.CreateGoalRequest\x1a\x1a.master.CreateGoalResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/goals\x12`\n" +
+	"\n" +
+	"UpdateGoal\x12\x19.master.UpdateGoalRequest\x1a\x1a.master.UpdateGoalResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/goals/{goal_id}\x12m\n" +
+	"\n" +
+	"DeleteGoal\x12\x19.master.DeleteGoalRequest\x1a\x1a.master.DeleteGoalResponse\"(\x82\xd3\xe4\x93\x02\"* /users/{user_id}/goals/{goal_id}\x12]\n" +
+	"\bGetGoals\x12\x17.master.GetGoalsRequest\x1a\x18.master.GetGoalsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/users/{user_id}/goals\x12\x89\x01\n" +
+	"\x13AddGoalContribution\x12\".master.AddGoalContributionRequest\x1a#.master.AddGoalContributionResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/goals/{goal_id}/contributions\x12\xb0\x01\n" +
+	"\x16RemoveGoalContribution\x12%.master.RemoveGoalContributionRequest\x1a&.master.RemoveGoalContributionResponse\"G\x82\xd3\xe4\x93\x02A*?/users/{user_id}/goals/{goal_id}/contributions/{transaction_id}\x12t\n" +
+	"\x0eListCategories\x12\x1d.master.ListCategoriesRequest\x1a\x1e.master.ListCategoriesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/users/{user_id}/categories\x12g\n" +
+	"\x0eCreateCategory\x12\x1d.master.CreateCategoryRequest\x1a\x1e.master.CreateCategoryResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/categories\x12u\n" +
+	"\x0eUpdateCategory\x12\x1d.master.UpdateCategoryRequest\x1a\x1e.master.UpdateCategoryResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/categories/{category_id}\x12\x82\x01\n" +
+	"\x0eDeleteCategory\x12\x1d.master.DeleteCategoryRequest\x1a\x1e.master.DeleteCategoryResponse\"1\x82\xd3\xe4\x93\x02+*)/users/{user_id}/categories/{category_id}\x12`\n" +
+	"\tListRules\x12\x18.master.ListRulesRequest\x1a\x19.master.ListRulesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/users/{user_id}/rules\x12V\n" +
+	"\n" +
+	"CreateRule\x12\x19.master.CreateRuleRequest\x1a\x1a.master.CreateRuleResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/rules\x12`\n" +
+	"\n" +
+	"UpdateRule\x12\x19.master.UpdateRuleRequest\x1a\x1a.master.UpdateRuleResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/rules/{rule_id}\x12m\n" +
+	"\n" +
+	"DeleteRule\x12\x19.master.DeleteRuleRequest\x1a\x1a.master.DeleteRuleResponse\"(\x82\xd3\xe4\x93\x02\"* /users/{user_id}/rules/{rule_id}\x12d\n" +
+	"\fReorderRules\x12\x1b.master.ReorderRulesRequest\x1a\x1c.master.ReorderRulesResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/rules/reorder\x12^\n" +
+	"\n" +
+	"DryRunRule\x12\x19.master.DryRunRuleRequest\x1a\x1a.master.DryRunRuleResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/rules/dry-run\x12\\\n" +
+	"\n" +
+	"ApplyRules\x12\x19.master.ApplyRulesRequest\x1a\x1a.master.ApplyRulesResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/rules/apply\x12\x85\x01\n" +
+	"\x12ListImportProfiles\x12!.master.ListImportProfilesRequest\x1a\".master.ListImportProfilesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /users/{user_id}/import-profiles\x12{\n" +
+	"\x13CreateImportProfile\x12\".master.CreateImportProfileRequest\x1a#.master.CreateImportProfileResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/import-profiles\x12\x88\x01\n" +
+	"\x13UpdateImportProfile\x12\".master.UpdateImportProfileRequest\x1a#.master.UpdateImportProfileResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/import-profiles/{profile_id}\x12\x95\x01\n" +
+	"\x13DeleteImportProfile\x12\".master.DeleteImportProfileRequest\x1a#.master.DeleteImportProfileResponse\"5\x82\xd3\xe4\x93\x02/*-/users/{user_id}/import-profiles/{profile_id}\x12p\n" +
+	"\x12ImportTransactions\x12!.master.ImportTransactionsRequest\x1a\".master.ImportTransactionsResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/imports\x12\x94\x01\n" +
+	"\x19ListRecurringTransactions\x12(.master.ListRecurringTransactionsRequest\x1a).master.ListRecurringTransactionsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/users/{user_id}/recurring\x12\x8a\x01\n" +
+	"\x1aCreateRecurringTransaction\x12).master.CreateRecurringTransactionRequest\x1a*.master.CreateRecurringTransactionResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/recurring\x12\x9c\x01\n" +
+	"\x19PauseRecurringTransaction\x12(.master.PauseRecurringTransactionRequest\x1a).master.PauseRecurringTransactionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/recurring/{recurring_id}/pause\x12\x98\x01\n" +
+	"\x18SkipRecurringTransaction\x12'.master.SkipRecurringTransactionRequest\x1a(.master.SkipRecurringTransactionResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/recurring/{recurring_id}/skip\x12\xa6\x01\n" +
+	"\x1aDeleteRecurringTransaction\x12).master.DeleteRecurringTransactionRequest\x1a*.master.DeleteRecurringTransactionResponse\"1\x82\xd3\xe4\x93\x02+*)/users/{user_id}/recurring/{recurring_id}\x12\x93\x01\n" +
+	"\x14SetTransactionSplits\x12#.master.SetTransactionSplitsRequest\x1a$.master.SetTransactionSplitsResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/transactions/{transaction_id}/splits\x12\\\n" +
+	"\bListTags\x12\x17.master.ListTagsRequest\x1a\x18.master.ListTagsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/users/{user_id}/tags\x12\x8b\x01\n" +
+	"\x12AddTransactionTags\x12!.master.AddTransactionTagsRequest\x1a\".master.AddTransactionTagsResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/transactions/{transaction_id}/tags\x12\xa1\x01\n" +
+	"\x15RemoveTransactionTags\x12$.master.RemoveTransactionTagsRequest\x1a%.master.RemoveTransactionTagsResponse\";\x82\xd3\xe4\x93\x025*3/users/{user_id}/transactions/{transaction_id}/tags\x12s\n" +
+	"\rGetTagSummary\x12\x1c.master.GetTagSummaryRequest\x1a\x1d.master.GetTagSummaryResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/users/{user_id}/tags/summary\x12\x82\x01\n" +
+	"\x11GetBalanceHistory\x12 .master.GetBalanceHistoryRequest\x1a!.master.GetBalanceHistoryResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /users/{user_id}/balance/history\x12n\n" +
+	"\fGetBalanceAt\x12\x1b.master.GetBalanceAtRequest\x1a\x1c.master.GetBalanceAtResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/users/{user_id}/balance/atB\x7f\n" +
 	"\n" +
 	"com.masterB\vMasterProtoP\x01Z,backend-master/internal/api-gen/proto/master\xa2\x02\x03MXX\xaa\x02\x06Master\xca\x02\x06Master\xe2\x02\x12Master\\GPBMetadata\xea\x02\x06Masterb\x06proto3"
 
@@ -626,55 +9481,456 @@ func file_master_master_proto_rawDescGZIP() []byte {
 	return file_master_master_proto_rawDescData
 }
 
-var file_master_master_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_master_master_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_master_master_proto_msgTypes = make([]protoimpl.MessageInfo, 146)
 var file_master_master_proto_goTypes = []any{
-	(*CreateTransactionRequest)(nil),       // 0: master.CreateTransactionRequest
-	(*CreateTransactionResponse)(nil),      // 1: master.CreateTransactionResponse
-	(*GetTransactionsRequest)(nil),         // 2: master.GetTransactionsRequest
-	(*GetTransactionsResponse)(nil),        // 3: master.GetTransactionsResponse
-	(*GetBalanceRequest)(nil),              // 4: master.GetBalanceRequest
-	(*GetBalanceResponse)(nil),             // 5: master.GetBalanceResponse
-	(*GetAnalyticsRequest)(nil),            // 6: master.GetAnalyticsRequest
-	(*GetAnalyticsResponse)(nil),           // 7: master.GetAnalyticsResponse
-	(*GetForecastRequest)(nil),             // 8: master.GetForecastRequest
-	(*GetForecastResponse)(nil),            // 9: master.GetForecastResponse
-	(common.TransactionType)(0),            // 10: common.TransactionType
-	(*common.Money)(nil),                   // 11: common.Money
-	(*timestamppb.Timestamp)(nil),          // 12: google.protobuf.Timestamp
-	(*wallet.Transaction)(nil),             // 13: wallet.Transaction
-	(*wallet.Account)(nil),                 // 14: wallet.Account
-	(*analyzer.GetStatisticsResponse)(nil), // 15: analyzer.GetStatisticsResponse
-	(common.TimePeriod)(0),                 // 16: common.TimePeriod
-	(*analyzer.Forecast)(nil),              // 17: analyzer.Forecast
+	(GoalAccountState)(0),                       // 0: master.GoalAccountState
+	(ImportSignConvention)(0),                   // 1: master.ImportSignConvention
+	(RecurrenceFrequency)(0),                    // 2: master.RecurrenceFrequency
+	(ImportFormat)(0),                           // 3: master.ImportFormat
+	(ImportRowStatus)(0),                        // 4: master.ImportRowStatus
+	(*CreateTransactionRequest)(nil),            // 5: master.CreateTransactionRequest
+	(*CreateTransactionResponse)(nil),           // 6: master.CreateTransactionResponse
+	(*UpdateTransactionRequest)(nil),            // 7: master.UpdateTransactionRequest
+	(*UpdateTransactionResponse)(nil),           // 8: master.UpdateTransactionResponse
+	(*DeleteTransactionRequest)(nil),            // 9: master.DeleteTransactionRequest
+	(*DeleteTransactionResponse)(nil),           // 10: master.DeleteTransactionResponse
+	(*GetTransactionsRequest)(nil),              // 11: master.GetTransactionsRequest
+	(*GetTransactionsResponse)(nil),             // 12: master.GetTransactionsResponse
+	(*GetBalanceRequest)(nil),                   // 13: master.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                  // 14: master.GetBalanceResponse
+	(*AccountBalance)(nil),                      // 15: master.AccountBalance
+	(*CreateAccountRequest)(nil),                // 16: master.CreateAccountRequest
+	(*CreateAccountResponse)(nil),               // 17: master.CreateAccountResponse
+	(*UpdateAccountRequest)(nil),                // 18: master.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),               // 19: master.UpdateAccountResponse
+	(*ArchiveAccountRequest)(nil),               // 20: master.ArchiveAccountRequest
+	(*ArchiveAccountResponse)(nil),              // 21: master.ArchiveAccountResponse
+	(*DeleteAccountRequest)(nil),                // 22: master.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),               // 23: master.DeleteAccountResponse
+	(*GetAnalyticsRequest)(nil),                 // 24: master.GetAnalyticsRequest
+	(*GetAnalyticsResponse)(nil),                // 25: master.GetAnalyticsResponse
+	(*GetForecastRequest)(nil),                  // 26: master.GetForecastRequest
+	(*GetForecastResponse)(nil),                 // 27: master.GetForecastResponse
+	(*GetInvestmentPositionsRequest)(nil),       // 28: master.GetInvestmentPositionsRequest
+	(*GetInvestmentPositionsResponse)(nil),      // 29: master.GetInvestmentPositionsResponse
+	(*GetSecurityRequest)(nil),                  // 30: master.GetSecurityRequest
+	(*GetSecurityResponse)(nil),                 // 31: master.GetSecurityResponse
+	(*GetSecuritiesPricesRequest)(nil),          // 32: master.GetSecuritiesPricesRequest
+	(*GetSecuritiesPricesResponse)(nil),         // 33: master.GetSecuritiesPricesResponse
+	(*GetSecurityPaymentsRequest)(nil),          // 34: master.GetSecurityPaymentsRequest
+	(*GetSecurityPaymentsResponse)(nil),         // 35: master.GetSecurityPaymentsResponse
+	(*BrokerLink)(nil),                          // 36: master.BrokerLink
+	(*LinkBrokerRequest)(nil),                   // 37: master.LinkBrokerRequest
+	(*LinkBrokerResponse)(nil),                  // 38: master.LinkBrokerResponse
+	(*UnlinkBrokerRequest)(nil),                 // 39: master.UnlinkBrokerRequest
+	(*UnlinkBrokerResponse)(nil),                // 40: master.UnlinkBrokerResponse
+	(*GetNetWorthRequest)(nil),                  // 41: master.GetNetWorthRequest
+	(*GetNetWorthResponse)(nil),                 // 42: master.GetNetWorthResponse
+	(*NetWorthAccount)(nil),                     // 43: master.NetWorthAccount
+	(*NetWorthSecurity)(nil),                    // 44: master.NetWorthSecurity
+	(*NetWorthSecurityType)(nil),                // 45: master.NetWorthSecurityType
+	(*GetAnomaliesRequest)(nil),                 // 46: master.GetAnomaliesRequest
+	(*GetAnomaliesResponse)(nil),                // 47: master.GetAnomaliesResponse
+	(*GetUpcomingRecurringRequest)(nil),         // 48: master.GetUpcomingRecurringRequest
+	(*GetUpcomingRecurringResponse)(nil),        // 49: master.GetUpcomingRecurringResponse
+	(*Notification)(nil),                        // 50: master.Notification
+	(*ListNotificationsRequest)(nil),            // 51: master.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),           // 52: master.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),        // 53: master.MarkNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil),       // 54: master.MarkNotificationsReadResponse
+	(*DeleteNotificationRequest)(nil),           // 55: master.DeleteNotificationRequest
+	(*DeleteNotificationResponse)(nil),          // 56: master.DeleteNotificationResponse
+	(*GetUnreadNotificationsCountRequest)(nil),  // 57: master.GetUnreadNotificationsCountRequest
+	(*GetUnreadNotificationsCountResponse)(nil), // 58: master.GetUnreadNotificationsCountResponse
+	(*Budget)(nil),                              // 59: master.Budget
+	(*BudgetStatus)(nil),                        // 60: master.BudgetStatus
+	(*CreateBudgetRequest)(nil),                 // 61: master.CreateBudgetRequest
+	(*CreateBudgetResponse)(nil),                // 62: master.CreateBudgetResponse
+	(*UpdateBudgetRequest)(nil),                 // 63: master.UpdateBudgetRequest
+	(*UpdateBudgetResponse)(nil),                // 64: master.UpdateBudgetResponse
+	(*DeleteBudgetRequest)(nil),                 // 65: master.DeleteBudgetRequest
+	(*DeleteBudgetResponse)(nil),                // 66: master.DeleteBudgetResponse
+	(*ListBudgetsRequest)(nil),                  // 67: master.ListBudgetsRequest
+	(*ListBudgetsResponse)(nil),                 // 68: master.ListBudgetsResponse
+	(*GetBudgetStatusRequest)(nil),              // 69: master.GetBudgetStatusRequest
+	(*GetBudgetStatusResponse)(nil),             // 70: master.GetBudgetStatusResponse
+	(*Goal)(nil),                                // 71: master.Goal
+	(*GoalProgress)(nil),                        // 72: master.GoalProgress
+	(*CreateGoalRequest)(nil),                   // 73: master.CreateGoalRequest
+	(*CreateGoalResponse)(nil),                  // 74: master.CreateGoalResponse
+	(*UpdateGoalRequest)(nil),                   // 75: master.UpdateGoalRequest
+	(*UpdateGoalResponse)(nil),                  // 76: master.UpdateGoalResponse
+	(*DeleteGoalRequest)(nil),                   // 77: master.DeleteGoalRequest
+	(*DeleteGoalResponse)(nil),                  // 78: master.DeleteGoalResponse
+	(*GetGoalsRequest)(nil),                     // 79: master.GetGoalsRequest
+	(*GetGoalsResponse)(nil),                    // 80: master.GetGoalsResponse
+	(*AddGoalContributionRequest)(nil),          // 81: master.AddGoalContributionRequest
+	(*AddGoalContributionResponse)(nil),         // 82: master.AddGoalContributionResponse
+	(*RemoveGoalContributionRequest)(nil),       // 83: master.RemoveGoalContributionRequest
+	(*RemoveGoalContributionResponse)(nil),      // 84: master.RemoveGoalContributionResponse
+	(*Category)(nil),                            // 85: master.Category
+	(*ListCategoriesRequest)(nil),               // 86: master.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),              // 87: master.ListCategoriesResponse
+	(*CreateCategoryRequest)(nil),               // 88: master.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),              // 89: master.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),               // 90: master.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),              // 91: master.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),               // 92: master.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),              // 93: master.DeleteCategoryResponse
+	(*TransactionRule)(nil),                     // 94: master.TransactionRule
+	(*ListRulesRequest)(nil),                    // 95: master.ListRulesRequest
+	(*ListRulesResponse)(nil),                   // 96: master.ListRulesResponse
+	(*CreateRuleRequest)(nil),                   // 97: master.CreateRuleRequest
+	(*CreateRuleResponse)(nil),                  // 98: master.CreateRuleResponse
+	(*UpdateRuleRequest)(nil),                   // 99: master.UpdateRuleRequest
+	(*UpdateRuleResponse)(nil),                  // 100: master.UpdateRuleResponse
+	(*DeleteRuleRequest)(nil),                   // 101: master.DeleteRuleRequest
+	(*DeleteRuleResponse)(nil),                  // 102: master.DeleteRuleResponse
+	(*ReorderRulesRequest)(nil),                 // 103: master.ReorderRulesRequest
+	(*ReorderRulesResponse)(nil),                // 104: master.ReorderRulesResponse
+	(*RuleChange)(nil),                          // 105: master.RuleChange
+	(*DryRunRuleRequest)(nil),                   // 106: master.DryRunRuleRequest
+	(*DryRunRuleResponse)(nil),                  // 107: master.DryRunRuleResponse
+	(*ApplyRulesRequest)(nil),                   // 108: master.ApplyRulesRequest
+	(*ApplyRulesResponse)(nil),                  // 109: master.ApplyRulesResponse
+	(*ImportProfile)(nil),                       // 110: master.ImportProfile
+	(*ListImportProfilesRequest)(nil),           // 111: master.ListImportProfilesRequest
+	(*ListImportProfilesResponse)(nil),          // 112: master.ListImportProfilesResponse
+	(*CreateImportProfileRequest)(nil),          // 113: master.CreateImportProfileRequest
+	(*CreateImportProfileResponse)(nil),         // 114: master.CreateImportProfileResponse
+	(*UpdateImportProfileRequest)(nil),          // 115: master.UpdateImportProfileRequest
+	(*UpdateImportProfileResponse)(nil),         // 116: master.UpdateImportProfileResponse
+	(*DeleteImportProfileRequest)(nil),          // 117: master.DeleteImportProfileRequest
+	(*DeleteImportProfileResponse)(nil),         // 118: master.DeleteImportProfileResponse
+	(*ImportRowResult)(nil),                     // 119: master.ImportRowResult
+	(*ImportTransactionsRequest)(nil),           // 120: master.ImportTransactionsRequest
+	(*ImportTransactionsResponse)(nil),          // 121: master.ImportTransactionsResponse
+	(*RecurringTransaction)(nil),                // 122: master.RecurringTransaction
+	(*ListRecurringTransactionsRequest)(nil),    // 123: master.ListRecurringTransactionsRequest
+	(*ListRecurringTransactionsResponse)(nil),   // 124: master.ListRecurringTransactionsResponse
+	(*CreateRecurringTransactionRequest)(nil),   // 125: master.CreateRecurringTransactionRequest
+	(*CreateRecurringTransactionResponse)(nil),  // 126: master.CreateRecurringTransactionResponse
+	(*PauseRecurringTransactionRequest)(nil),    // 127: master.PauseRecurringTransactionRequest
+	(*PauseRecurringTransactionResponse)(nil),   // 128: master.PauseRecurringTransactionResponse
+	(*SkipRecurringTransactionRequest)(nil),     // 129: master.SkipRecurringTransactionRequest
+	(*SkipRecurringTransactionResponse)(nil),    // 130: master.SkipRecurringTransactionResponse
+	(*DeleteRecurringTransactionRequest)(nil),   // 131: master.DeleteRecurringTransactionRequest
+	(*DeleteRecurringTransactionResponse)(nil),  // 132: master.DeleteRecurringTransactionResponse
+	(*SetTransactionSplitsRequest)(nil),         // 133: master.SetTransactionSplitsRequest
+	(*SetTransactionSplitsResponse)(nil),        // 134: master.SetTransactionSplitsResponse
+	(*Tag)(nil),                                 // 135: master.Tag
+	(*ListTagsRequest)(nil),                     // 136: master.ListTagsRequest
+	(*ListTagsResponse)(nil),                    // 137: master.ListTagsResponse
+	(*AddTransactionTagsRequest)(nil),           // 138: master.AddTransactionTagsRequest
+	(*AddTransactionTagsResponse)(nil),          // 139: master.AddTransactionTagsResponse
+	(*RemoveTransactionTagsRequest)(nil),        // 140: master.RemoveTransactionTagsRequest
+	(*RemoveTransactionTagsResponse)(nil),       // 141: master.RemoveTransactionTagsResponse
+	(*TagSummary)(nil),                          // 142: master.TagSummary
+	(*GetTagSummaryRequest)(nil),                // 143: master.GetTagSummaryRequest
+	(*GetTagSummaryResponse)(nil),               // 144: master.GetTagSummaryResponse
+	(*GetBalanceHistoryRequest)(nil),            // 145: master.GetBalanceHistoryRequest
+	(*GetBalanceHistoryResponse)(nil),           // 146: master.GetBalanceHistoryResponse
+	(*BalanceHistoryPoint)(nil),                 // 147: master.BalanceHistoryPoint
+	(*AccountBalancePoint)(nil),                 // 148: master.AccountBalancePoint
+	(*GetBalanceAtRequest)(nil),                 // 149: master.GetBalanceAtRequest
+	(*GetBalanceAtResponse)(nil),                // 150: master.GetBalanceAtResponse
+	(common.TransactionType)(0),                 // 151: common.TransactionType
+	(*common.Money)(nil),                        // 152: common.Money
+	(*timestamppb.Timestamp)(nil),               // 153: google.protobuf.Timestamp
+	(*wallet.Transaction)(nil),                  // 154: wallet.Transaction
+	(*wallet.Account)(nil),                      // 155: wallet.Account
+	(common.AccountType)(0),                     // 156: common.AccountType
+	(common.TimePeriod)(0),                      // 157: common.TimePeriod
+	(*analyzer.GetStatisticsResponse)(nil),      // 158: analyzer.GetStatisticsResponse
+	(*analyzer.Forecast)(nil),                   // 159: analyzer.Forecast
+	(*market.InvestmentPosition)(nil),           // 160: market.InvestmentPosition
+	(*market.Security)(nil),                     // 161: market.Security
+	(*market.SecurityPayment)(nil),              // 162: market.SecurityPayment
+	(*analyzer.CategoryAnomaly)(nil),            // 163: analyzer.CategoryAnomaly
+	(*analyzer.RecurringPayment)(nil),           // 164: analyzer.RecurringPayment
+	(*wallet.TransactionSplit)(nil),             // 165: wallet.TransactionSplit
 }
 var file_master_master_proto_depIdxs = []int32{
-	10, // 0: master.CreateTransactionRequest.type:type_name -> common.TransactionType
-	11, // 1: master.CreateTransactionRequest.amount:type_name -> common.Money
-	12, // 2: master.CreateTransactionRequest.date:type_name -> google.protobuf.Timestamp
-	13, // 3: master.CreateTransactionResponse.transaction:type_name -> wallet.Transaction
-	13, // 4: master.GetTransactionsResponse.transactions:type_name -> wallet.Transaction
-	11, // 5: master.GetBalanceResponse.total_balance:type_name -> common.Money
-	14, // 6: master.GetBalanceResponse.accounts:type_name -> wallet.Account
-	12, // 7: master.GetAnalyticsRequest.start_date:type_name -> google.protobuf.Timestamp
-	12, // 8: master.GetAnalyticsRequest.end_date:type_name -> google.protobuf.Timestamp
-	15, // 9: master.GetAnalyticsResponse.statistics:type_name -> analyzer.GetStatisticsResponse
-	16, // 10: master.GetForecastRequest.period:type_name -> common.TimePeriod
-	17, // 11: master.GetForecastResponse.forecasts:type_name -> analyzer.Forecast
-	0,  // 12: master.MasterService.CreateTransaction:input_type -> master.CreateTransactionRequest
-	2,  // 13: master.MasterService.GetTransactions:input_type -> master.GetTransactionsRequest
-	4,  // 14: master.MasterService.GetBalance:input_type -> master.GetBalanceRequest
-	6,  // 15: master.MasterService.GetAnalytics:input_type -> master.GetAnalyticsRequest
-	8,  // 16: master.MasterService.GetForecast:input_type -> master.GetForecastRequest
-	1,  // 17: master.MasterService.CreateTransaction:output_type -> master.CreateTransactionResponse
-	3,  // 18: master.MasterService.GetTransactions:output_type -> master.GetTransactionsResponse
-	5,  // 19: master.MasterService.GetBalance:output_type -> master.GetBalanceResponse
-	7,  // 20: master.MasterService.GetAnalytics:output_type -> master.GetAnalyticsResponse
-	9,  // 21: master.MasterService.GetForecast:output_type -> master.GetForecastResponse
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	151, // 0: master.CreateTransactionRequest.type:type_name -> common.TransactionType
+	152, // 1: master.CreateTransactionRequest.amount:type_name -> common.Money
+	153, // 2: master.CreateTransactionRequest.date:type_name -> google.protobuf.Timestamp
+	152, // 3: master.CreateTransactionRequest.to_amount:type_name -> common.Money
+	154, // 4: master.CreateTransactionResponse.transaction:type_name -> wallet.Transaction
+	151, // 5: master.UpdateTransactionRequest.type:type_name -> common.TransactionType
+	152, // 6: master.UpdateTransactionRequest.amount:type_name -> common.Money
+	153, // 7: master.UpdateTransactionRequest.date:type_name -> google.protobuf.Timestamp
+	152, // 8: master.UpdateTransactionRequest.to_amount:type_name -> common.Money
+	154, // 9: master.UpdateTransactionResponse.transaction:type_name -> wallet.Transaction
+	153, // 10: master.GetTransactionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	153, // 11: master.GetTransactionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	151, // 12: master.GetTransactionsRequest.type:type_name -> common.TransactionType
+	154, // 13: master.GetTransactionsResponse.transactions:type_name -> wallet.Transaction
+	152, // 14: master.GetBalanceResponse.total_balance:type_name -> common.Money
+	155, // 15: master.GetBalanceResponse.accounts:type_name -> wallet.Account
+	15,  // 16: master.GetBalanceResponse.account_balances:type_name -> master.AccountBalance
+	152, // 17: master.AccountBalance.balance:type_name -> common.Money
+	152, // 18: master.AccountBalance.converted_balance:type_name -> common.Money
+	153, // 19: master.AccountBalance.rate_date:type_name -> google.protobuf.Timestamp
+	156, // 20: master.CreateAccountRequest.type:type_name -> common.AccountType
+	152, // 21: master.CreateAccountRequest.initial_balance:type_name -> common.Money
+	155, // 22: master.CreateAccountResponse.account:type_name -> wallet.Account
+	155, // 23: master.UpdateAccountResponse.account:type_name -> wallet.Account
+	155, // 24: master.ArchiveAccountResponse.account:type_name -> wallet.Account
+	153, // 25: master.GetAnalyticsRequest.start_date:type_name -> google.protobuf.Timestamp
+	153, // 26: master.GetAnalyticsRequest.end_date:type_name -> google.protobuf.Timestamp
+	157, // 27: master.GetAnalyticsRequest.group_by:type_name -> common.TimePeriod
+	158, // 28: master.GetAnalyticsResponse.statistics:type_name -> analyzer.GetStatisticsResponse
+	157, // 29: master.GetForecastRequest.period:type_name -> common.TimePeriod
+	159, // 30: master.GetForecastResponse.forecasts:type_name -> analyzer.Forecast
+	160, // 31: master.GetInvestmentPositionsResponse.positions:type_name -> market.InvestmentPosition
+	161, // 32: master.GetSecurityResponse.security:type_name -> market.Security
+	161, // 33: master.GetSecuritiesPricesResponse.securities:type_name -> market.Security
+	153, // 34: master.GetSecurityPaymentsRequest.start_date:type_name -> google.protobuf.Timestamp
+	153, // 35: master.GetSecurityPaymentsRequest.end_date:type_name -> google.protobuf.Timestamp
+	162, // 36: master.GetSecurityPaymentsResponse.payments:type_name -> market.SecurityPayment
+	153, // 37: master.BrokerLink.created_at:type_name -> google.protobuf.Timestamp
+	153, // 38: master.BrokerLink.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 39: master.LinkBrokerResponse.link:type_name -> master.BrokerLink
+	152, // 40: master.GetNetWorthResponse.total:type_name -> common.Money
+	152, // 41: master.GetNetWorthResponse.cash_total:type_name -> common.Money
+	152, // 42: master.GetNetWorthResponse.investments_total:type_name -> common.Money
+	43,  // 43: master.GetNetWorthResponse.accounts:type_name -> master.NetWorthAccount
+	44,  // 44: master.GetNetWorthResponse.securities:type_name -> master.NetWorthSecurity
+	45,  // 45: master.GetNetWorthResponse.security_types:type_name -> master.NetWorthSecurityType
+	153, // 46: master.GetNetWorthResponse.valued_at:type_name -> google.protobuf.Timestamp
+	156, // 47: master.NetWorthAccount.type:type_name -> common.AccountType
+	152, // 48: master.NetWorthAccount.value:type_name -> common.Money
+	153, // 49: master.NetWorthAccount.valued_at:type_name -> google.protobuf.Timestamp
+	152, // 50: master.NetWorthSecurity.price:type_name -> common.Money
+	152, // 51: master.NetWorthSecurity.value:type_name -> common.Money
+	153, // 52: master.NetWorthSecurity.price_updated_at:type_name -> google.protobuf.Timestamp
+	152, // 53: master.NetWorthSecurityType.value:type_name -> common.Money
+	157, // 54: master.GetAnomaliesRequest.period:type_name -> common.TimePeriod
+	163, // 55: master.GetAnomaliesResponse.anomalies:type_name -> analyzer.CategoryAnomaly
+	164, // 56: master.GetUpcomingRecurringResponse.payments:type_name -> analyzer.RecurringPayment
+	153, // 57: master.Notification.created_at:type_name -> google.protobuf.Timestamp
+	153, // 58: master.Notification.sent_at:type_name -> google.protobuf.Timestamp
+	153, // 59: master.Notification.read_at:type_name -> google.protobuf.Timestamp
+	50,  // 60: master.ListNotificationsResponse.notifications:type_name -> master.Notification
+	157, // 61: master.Budget.period:type_name -> common.TimePeriod
+	152, // 62: master.Budget.limit:type_name -> common.Money
+	153, // 63: master.Budget.created_at:type_name -> google.protobuf.Timestamp
+	59,  // 64: master.BudgetStatus.budget:type_name -> master.Budget
+	152, // 65: master.BudgetStatus.spent:type_name -> common.Money
+	152, // 66: master.BudgetStatus.remaining:type_name -> common.Money
+	153, // 67: master.BudgetStatus.period_start:type_name -> google.protobuf.Timestamp
+	153, // 68: master.BudgetStatus.period_end:type_name -> google.protobuf.Timestamp
+	157, // 69: master.CreateBudgetRequest.period:type_name -> common.TimePeriod
+	152, // 70: master.CreateBudgetRequest.limit:type_name -> common.Money
+	59,  // 71: master.CreateBudgetResponse.budget:type_name -> master.Budget
+	152, // 72: master.UpdateBudgetRequest.limit:type_name -> common.Money
+	59,  // 73: master.UpdateBudgetResponse.budget:type_name -> master.Budget
+	59,  // 74: master.ListBudgetsResponse.budgets:type_name -> master.Budget
+	153, // 75: master.GetBudgetStatusRequest.date:type_name -> google.protobuf.Timestamp
+	60,  // 76: master.GetBudgetStatusResponse.statuses:type_name -> master.BudgetStatus
+	152, // 77: master.Goal.target:type_name -> common.Money
+	153, // 78: master.Goal.deadline:type_name -> google.protobuf.Timestamp
+	153, // 79: master.Goal.created_at:type_name -> google.protobuf.Timestamp
+	71,  // 80: master.GoalProgress.goal:type_name -> master.Goal
+	152, // 81: master.GoalProgress.current:type_name -> common.Money
+	152, // 82: master.GoalProgress.remaining:type_name -> common.Money
+	153, // 83: master.GoalProgress.projected_completion:type_name -> google.protobuf.Timestamp
+	0,   // 84: master.GoalProgress.account_state:type_name -> master.GoalAccountState
+	152, // 85: master.CreateGoalRequest.target:type_name -> common.Money
+	153, // 86: master.CreateGoalRequest.deadline:type_name -> google.protobuf.Timestamp
+	71,  // 87: master.CreateGoalResponse.goal:type_name -> master.Goal
+	152, // 88: master.UpdateGoalRequest.target:type_name -> common.Money
+	153, // 89: master.UpdateGoalRequest.deadline:type_name -> google.protobuf.Timestamp
+	71,  // 90: master.UpdateGoalResponse.goal:type_name -> master.Goal
+	72,  // 91: master.GetGoalsResponse.goals:type_name -> master.GoalProgress
+	85,  // 92: master.ListCategoriesResponse.categories:type_name -> master.Category
+	85,  // 93: master.CreateCategoryResponse.category:type_name -> master.Category
+	85,  // 94: master.UpdateCategoryResponse.category:type_name -> master.Category
+	94,  // 95: master.ListRulesResponse.rules:type_name -> master.TransactionRule
+	94,  // 96: master.CreateRuleRequest.rule:type_name -> master.TransactionRule
+	94,  // 97: master.CreateRuleResponse.rule:type_name -> master.TransactionRule
+	94,  // 98: master.UpdateRuleRequest.rule:type_name -> master.TransactionRule
+	94,  // 99: master.UpdateRuleResponse.rule:type_name -> master.TransactionRule
+	94,  // 100: master.ReorderRulesResponse.rules:type_name -> master.TransactionRule
+	154, // 101: master.RuleChange.transaction:type_name -> wallet.Transaction
+	94,  // 102: master.DryRunRuleRequest.rule:type_name -> master.TransactionRule
+	105, // 103: master.DryRunRuleResponse.changes:type_name -> master.RuleChange
+	1,   // 104: master.ImportProfile.sign_convention:type_name -> master.ImportSignConvention
+	110, // 105: master.ListImportProfilesResponse.profiles:type_name -> master.ImportProfile
+	110, // 106: master.CreateImportProfileRequest.profile:type_name -> master.ImportProfile
+	110, // 107: master.CreateImportProfileResponse.profile:type_name -> master.ImportProfile
+	110, // 108: master.UpdateImportProfileRequest.profile:type_name -> master.ImportProfile
+	110, // 109: master.UpdateImportProfileResponse.profile:type_name -> master.ImportProfile
+	4,   // 110: master.ImportRowResult.status:type_name -> master.ImportRowStatus
+	154, // 111: master.ImportRowResult.transaction:type_name -> wallet.Transaction
+	110, // 112: master.ImportTransactionsRequest.profile:type_name -> master.ImportProfile
+	3,   // 113: master.ImportTransactionsRequest.format:type_name -> master.ImportFormat
+	119, // 114: master.ImportTransactionsResponse.rows:type_name -> master.ImportRowResult
+	151, // 115: master.RecurringTransaction.type:type_name -> common.TransactionType
+	152, // 116: master.RecurringTransaction.amount:type_name -> common.Money
+	2,   // 117: master.RecurringTransaction.frequency:type_name -> master.RecurrenceFrequency
+	153, // 118: master.RecurringTransaction.start_date:type_name -> google.protobuf.Timestamp
+	153, // 119: master.RecurringTransaction.end_date:type_name -> google.protobuf.Timestamp
+	153, // 120: master.RecurringTransaction.next_run_at:type_name -> google.protobuf.Timestamp
+	153, // 121: master.RecurringTransaction.created_at:type_name -> google.protobuf.Timestamp
+	122, // 122: master.ListRecurringTransactionsResponse.recurring:type_name -> master.RecurringTransaction
+	122, // 123: master.CreateRecurringTransactionRequest.recurring:type_name -> master.RecurringTransaction
+	122, // 124: master.CreateRecurringTransactionResponse.recurring:type_name -> master.RecurringTransaction
+	122, // 125: master.PauseRecurringTransactionResponse.recurring:type_name -> master.RecurringTransaction
+	122, // 126: master.SkipRecurringTransactionResponse.recurring:type_name -> master.RecurringTransaction
+	165, // 127: master.SetTransactionSplitsRequest.splits:type_name -> wallet.TransactionSplit
+	154, // 128: master.SetTransactionSplitsResponse.transaction:type_name -> wallet.Transaction
+	153, // 129: master.Tag.created_at:type_name -> google.protobuf.Timestamp
+	135, // 130: master.ListTagsResponse.tags:type_name -> master.Tag
+	152, // 131: master.TagSummary.income:type_name -> common.Money
+	152, // 132: master.TagSummary.expense:type_name -> common.Money
+	153, // 133: master.GetTagSummaryRequest.start_date:type_name -> google.protobuf.Timestamp
+	153, // 134: master.GetTagSummaryRequest.end_date:type_name -> google.protobuf.Timestamp
+	142, // 135: master.GetTagSummaryResponse.summaries:type_name -> master.TagSummary
+	157, // 136: master.GetBalanceHistoryRequest.interval:type_name -> common.TimePeriod
+	153, // 137: master.GetBalanceHistoryRequest.start_date:type_name -> google.protobuf.Timestamp
+	153, // 138: master.GetBalanceHistoryRequest.end_date:type_name -> google.protobuf.Timestamp
+	147, // 139: master.GetBalanceHistoryResponse.points:type_name -> master.BalanceHistoryPoint
+	153, // 140: master.BalanceHistoryPoint.period_start:type_name -> google.protobuf.Timestamp
+	153, // 141: master.BalanceHistoryPoint.period_end:type_name -> google.protobuf.Timestamp
+	152, // 142: master.BalanceHistoryPoint.total_balance:type_name -> common.Money
+	148, // 143: master.BalanceHistoryPoint.accounts:type_name -> master.AccountBalancePoint
+	152, // 144: master.AccountBalancePoint.balance:type_name -> common.Money
+	153, // 145: master.GetBalanceAtRequest.date:type_name -> google.protobuf.Timestamp
+	152, // 146: master.GetBalanceAtResponse.total_balance:type_name -> common.Money
+	15,  // 147: master.GetBalanceAtResponse.account_balances:type_name -> master.AccountBalance
+	5,   // 148: master.MasterService.CreateTransaction:input_type -> master.CreateTransactionRequest
+	7,   // 149: master.MasterService.UpdateTransaction:input_type -> master.UpdateTransactionRequest
+	9,   // 150: master.MasterService.DeleteTransaction:input_type -> master.DeleteTransactionRequest
+	11,  // 151: master.MasterService.GetTransactions:input_type -> master.GetTransactionsRequest
+	13,  // 152: master.MasterService.GetBalance:input_type -> master.GetBalanceRequest
+	16,  // 153: master.MasterService.CreateAccount:input_type -> master.CreateAccountRequest
+	18,  // 154: master.MasterService.UpdateAccount:input_type -> master.UpdateAccountRequest
+	20,  // 155: master.MasterService.ArchiveAccount:input_type -> master.ArchiveAccountRequest
+	22,  // 156: master.MasterService.DeleteAccount:input_type -> master.DeleteAccountRequest
+	24,  // 157: master.MasterService.GetAnalytics:input_type -> master.GetAnalyticsRequest
+	26,  // 158: master.MasterService.GetForecast:input_type -> master.GetForecastRequest
+	28,  // 159: master.MasterService.GetInvestmentPositions:input_type -> master.GetInvestmentPositionsRequest
+	30,  // 160: master.MasterService.GetSecurity:input_type -> master.GetSecurityRequest
+	32,  // 161: master.MasterService.GetSecuritiesPrices:input_type -> master.GetSecuritiesPricesRequest
+	34,  // 162: master.MasterService.GetSecurityPayments:input_type -> master.GetSecurityPaymentsRequest
+	37,  // 163: master.MasterService.LinkBroker:input_type -> master.LinkBrokerRequest
+	39,  // 164: master.MasterService.UnlinkBroker:input_type -> master.UnlinkBrokerRequest
+	41,  // 165: master.MasterService.GetNetWorth:input_type -> master.GetNetWorthRequest
+	46,  // 166: master.MasterService.GetAnomalies:input_type -> master.GetAnomaliesRequest
+	48,  // 167: master.MasterService.GetUpcomingRecurring:input_type -> master.GetUpcomingRecurringRequest
+	51,  // 168: master.MasterService.ListNotifications:input_type -> master.ListNotificationsRequest
+	53,  // 169: master.MasterService.MarkNotificationsRead:input_type -> master.MarkNotificationsReadRequest
+	55,  // 170: master.MasterService.DeleteNotification:input_type -> master.DeleteNotificationRequest
+	57,  // 171: master.MasterService.GetUnreadNotificationsCount:input_type -> master.GetUnreadNotificationsCountRequest
+	61,  // 172: master.MasterService.CreateBudget:input_type -> master.CreateBudgetRequest
+	63,  // 173: master.MasterService.UpdateBudget:input_type -> master.UpdateBudgetRequest
+	65,  // 174: master.MasterService.DeleteBudget:input_type -> master.DeleteBudgetRequest
+	67,  // 175: master.MasterService.ListBudgets:input_type -> master.ListBudgetsRequest
+	69,  // 176: master.MasterService.GetBudgetStatus:input_type -> master.GetBudgetStatusRequest
+	73,  // 177: master.MasterService.CreateGoal:input_type -> master.CreateGoalRequest
+	75,  // 178: master.MasterService.UpdateGoal:input_type -> master.UpdateGoalRequest
+	77,  // 179: master.MasterService.DeleteGoal:input_type -> master.DeleteGoalRequest
+	79,  // 180: master.MasterService.GetGoals:input_type -> master.GetGoalsRequest
+	81,  // 181: master.MasterService.AddGoalContribution:input_type -> master.AddGoalContributionRequest
+	83,  // 182: master.MasterService.RemoveGoalContribution:input_type -> master.RemoveGoalContributionRequest
+	86,  // 183: master.MasterService.ListCategories:input_type -> master.ListCategoriesRequest
+	88,  // 184: master.MasterService.CreateCategory:input_type -> master.CreateCategoryRequest
+	90,  // 185: master.MasterService.UpdateCategory:input_type -> master.UpdateCategoryRequest
+	92,  // 186: master.MasterService.DeleteCategory:input_type -> master.DeleteCategoryRequest
+	95,  // 187: master.MasterService.ListRules:input_type -> master.ListRulesRequest
+	97,  // 188: master.MasterService.CreateRule:input_type -> master.CreateRuleRequest
+	99,  // 189: master.MasterService.UpdateRule:input_type -> master.UpdateRuleRequest
+	101, // 190: master.MasterService.DeleteRule:input_type -> master.DeleteRuleRequest
+	103, // 191: master.MasterService.ReorderRules:input_type -> master.ReorderRulesRequest
+	106, // 192: master.MasterService.DryRunRule:input_type -> master.DryRunRuleRequest
+	108, // 193: master.MasterService.ApplyRules:input_type -> master.ApplyRulesRequest
+	111, // 194: master.MasterService.ListImportProfiles:input_type -> master.ListImportProfilesRequest
+	113, // 195: master.MasterService.CreateImportProfile:input_type -> master.CreateImportProfileRequest
+	115, // 196: master.MasterService.UpdateImportProfile:input_type -> master.UpdateImportProfileRequest
+	117, // 197: master.MasterService.DeleteImportProfile:input_type -> master.DeleteImportProfileRequest
+	120, // 198: master.MasterService.ImportTransactions:input_type -> master.ImportTransactionsRequest
+	123, // 199: master.MasterService.ListRecurringTransactions:input_type -> master.ListRecurringTransactionsRequest
+	125, // 200: master.MasterService.CreateRecurringTransaction:input_type -> master.CreateRecurringTransactionRequest
+	127, // 201: master.MasterService.PauseRecurringTransaction:input_type -> master.PauseRecurringTransactionRequest
+	129, // 202: master.MasterService.SkipRecurringTransaction:input_type -> master.SkipRecurringTransactionRequest
+	131, // 203: master.MasterService.DeleteRecurringTransaction:input_type -> master.DeleteRecurringTransactionRequest
+	133, // 204: master.MasterService.SetTransactionSplits:input_type -> master.SetTransactionSplitsRequest
+	136, // 205: master.MasterService.ListTags:input_type -> master.ListTagsRequest
+	138, // 206: master.MasterService.AddTransactionTags:input_type -> master.AddTransactionTagsRequest
+	140, // 207: master.MasterService.RemoveTransactionTags:input_type -> master.RemoveTransactionTagsRequest
+	143, // 208: master.MasterService.GetTagSummary:input_type -> master.GetTagSummaryRequest
+	145, // 209: master.MasterService.GetBalanceHistory:input_type -> master.GetBalanceHistoryRequest
+	149, // 210: master.MasterService.GetBalanceAt:input_type -> master.GetBalanceAtRequest
+	6,   // 211: master.MasterService.CreateTransaction:output_type -> master.CreateTransactionResponse
+	8,   // 212: master.MasterService.UpdateTransaction:output_type -> master.UpdateTransactionResponse
+	10,  // 213: master.MasterService.DeleteTransaction:output_type -> master.DeleteTransactionResponse
+	12,  // 214: master.MasterService.GetTransactions:output_type -> master.GetTransactionsResponse
+	14,  // 215: master.MasterService.GetBalance:output_type -> master.GetBalanceResponse
+	17,  // 216: master.MasterService.CreateAccount:output_type -> master.CreateAccountResponse
+	19,  // 217: master.MasterService.UpdateAccount:output_type -> master.UpdateAccountResponse
+	21,  // 218: master.MasterService.ArchiveAccount:output_type -> master.ArchiveAccountResponse
+	23,  // 219: master.MasterService.DeleteAccount:output_type -> master.DeleteAccountResponse
+	25,  // 220: master.MasterService.GetAnalytics:output_type -> master.GetAnalyticsResponse
+	27,  // 221: master.MasterService.GetForecast:output_type -> master.GetForecastResponse
+	29,  // 222: master.MasterService.GetInvestmentPositions:output_type -> master.GetInvestmentPositionsResponse
+	31,  // 223: master.MasterService.GetSecurity:output_type -> master.GetSecurityResponse
+	33,  // 224: master.MasterService.GetSecuritiesPrices:output_type -> master.GetSecuritiesPricesResponse
+	35,  // 225: master.MasterService.GetSecurityPayments:output_type -> master.GetSecurityPaymentsResponse
+	38,  // 226: master.MasterService.LinkBroker:output_type -> master.LinkBrokerResponse
+	40,  // 227: master.MasterService.UnlinkBroker:output_type -> master.UnlinkBrokerResponse
+	42,  // 228: master.MasterService.GetNetWorth:output_type -> master.GetNetWorthResponse
+	47,  // 229: master.MasterService.GetAnomalies:output_type -> master.GetAnomaliesResponse
+	49,  // 230: master.MasterService.GetUpcomingRecurring:output_type -> master.GetUpcomingRecurringResponse
+	52,  // 231: master.MasterService.ListNotifications:output_type -> master.ListNotificationsResponse
+	54,  // 232: master.MasterService.MarkNotificationsRead:output_type -> master.MarkNotificationsReadResponse
+	56,  // 233: master.MasterService.DeleteNotification:output_type -> master.DeleteNotificationResponse
+	58,  // 234: master.MasterService.GetUnreadNotificationsCount:output_type -> master.GetUnreadNotificationsCountResponse
+	62,  // 235: master.MasterService.CreateBudget:output_type -> master.CreateBudgetResponse
+	64,  // 236: master.MasterService.UpdateBudget:output_type -> master.UpdateBudgetResponse
+	66,  // 237: master.MasterService.DeleteBudget:output_type -> master.DeleteBudgetResponse
+	68,  // 238: master.MasterService.ListBudgets:output_type -> master.ListBudgetsResponse
+	70,  // 239: master.MasterService.GetBudgetStatus:output_type -> master.GetBudgetStatusResponse
+	74,  // 240: master.MasterService.CreateGoal:output_type -> master.CreateGoalResponse
+	76,  // 241: master.MasterService.UpdateGoal:output_type -> master.UpdateGoalResponse
+	78,  // 242: master.MasterService.DeleteGoal:output_type -> master.DeleteGoalResponse
+	80,  // 243: master.MasterService.GetGoals:output_type -> master.GetGoalsResponse
+	82,  // 244: master.MasterService.AddGoalContribution:output_type -> master.AddGoalContributionResponse
+	84,  // 245: master.MasterService.RemoveGoalContribution:output_type -> master.RemoveGoalContributionResponse
+	87,  // 246: master.MasterService.ListCategories:output_type -> master.ListCategoriesResponse
+	89,  // 247: master.MasterService.CreateCategory:output_type -> master.CreateCategoryResponse
+	91,  // 248: master.MasterService.UpdateCategory:output_type -> master.UpdateCategoryResponse
+	93,  // 249: master.MasterService.DeleteCategory:output_type -> master.DeleteCategoryResponse
+	96,  // 250: master.MasterService.ListRules:output_type -> master.ListRulesResponse
+	98,  // 251: master.MasterService.CreateRule:output_type -> master.CreateRuleResponse
+	100, // 252: master.MasterService.UpdateRule:output_type -> master.UpdateRuleResponse
+	102, // 253: master.MasterService.DeleteRule:output_type -> master.DeleteRuleResponse
+	104, // 254: master.MasterService.ReorderRules:output_type -> master.ReorderRulesResponse
+	107, // 255: master.MasterService.DryRunRule:output_type -> master.DryRunRuleResponse
+	109, // 256: master.MasterService.ApplyRules:output_type -> master.ApplyRulesResponse
+	112, // 257: master.MasterService.ListImportProfiles:output_type -> master.ListImportProfilesResponse
+	114, // 258: master.MasterService.CreateImportProfile:output_type -> master.CreateImportProfileResponse
+	116, // 259: master.MasterService.UpdateImportProfile:output_type -> master.UpdateImportProfileResponse
+	118, // 260: master.MasterService.DeleteImportProfile:output_type -> master.DeleteImportProfileResponse
+	121, // 261: master.MasterService.ImportTransactions:output_type -> master.ImportTransactionsResponse
+	124, // 262: master.MasterService.ListRecurringTransactions:output_type -> master.ListRecurringTransactionsResponse
+	126, // 263: master.MasterService.CreateRecurringTransaction:output_type -> master.CreateRecurringTransactionResponse
+	128, // 264: master.MasterService.PauseRecurringTransaction:output_type -> master.PauseRecurringTransactionResponse
+	130, // 265: master.MasterService.SkipRecurringTransaction:output_type -> master.SkipRecurringTransactionResponse
+	132, // 266: master.MasterService.DeleteRecurringTransaction:output_type -> master.DeleteRecurringTransactionResponse
+	134, // 267: master.MasterService.SetTransactionSplits:output_type -> master.SetTransactionSplitsResponse
+	137, // 268: master.MasterService.ListTags:output_type -> master.ListTagsResponse
+	139, // 269: master.MasterService.AddTransactionTags:output_type -> master.AddTransactionTagsResponse
+	141, // 270: master.MasterService.RemoveTransactionTags:output_type -> master.RemoveTransactionTagsResponse
+	144, // 271: master.MasterService.GetTagSummary:output_type -> master.GetTagSummaryResponse
+	146, // 272: master.MasterService.GetBalanceHistory:output_type -> master.GetBalanceHistoryResponse
+	150, // 273: master.MasterService.GetBalanceAt:output_type -> master.GetBalanceAtResponse
+	211, // [211:274] is the sub-list for method output_type
+	148, // [148:211] is the sub-list for method input_type
+	148, // [148:148] is the sub-list for extension type_name
+	148, // [148:148] is the sub-list for extension extendee
+	0,   // [0:148] is the sub-list for field type_name
 }
 
 func init() { file_master_master_proto_init() }
@@ -682,18 +9938,22 @@ func file_master_master_proto_init() {
 	if File_master_master_proto != nil {
 		return
 	}
+	file_master_master_proto_msgTypes[6].OneofWrappers = []any{}
+	file_master_master_proto_msgTypes[89].OneofWrappers = []any{}
+	file_master_master_proto_msgTypes[105].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_master_master_proto_rawDesc), len(file_master_master_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      5,
+			NumMessages:   146,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_master_master_proto_goTypes,
 		DependencyIndexes: file_master_master_proto_depIdxs,
+		EnumInfos:         file_master_master_proto_enumTypes,
 		MessageInfos:      file_master_master_proto_msgTypes,
 	}.Build()
 	File_master_master_proto = out.File
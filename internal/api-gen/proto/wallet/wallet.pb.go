@@ -31,6 +31,7 @@ type Account struct {
 	Type          common.AccountType     `protobuf:"varint,4,opt,name=type,proto3,enum=common.AccountType" json:"type,omitempty"`
 	Balance       *common.Money          `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Archived      bool                   `protobuf:"varint,7,opt,name=archived,proto3" json:"archived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -107,6 +108,13 @@ func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Account) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
 type Transaction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
@@ -118,6 +126,16 @@ type Transaction struct {
 	ToAccountId   string                 `protobuf:"bytes,7,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
 	Date          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
 	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	TransactionId string                 `protobuf:"bytes,10,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,11,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Mcc           int32                  `protobuf:"varint,12,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	Splits        []*TransactionSplit    `protobuf:"bytes,13,rep,name=splits,proto3" json:"splits,omitempty"`
+	Tags          []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,15,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	TransferId    string                 `protobuf:"bytes,16,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	DebitAmount   *common.Money          `protobuf:"bytes,17,opt,name=debit_amount,json=debitAmount,proto3" json:"debit_amount,omitempty"`
+	CreditAmount  *common.Money          `protobuf:"bytes,18,opt,name=credit_amount,json=creditAmount,proto3" json:"credit_amount,omitempty"`
+	ExchangeRate  string                 `protobuf:"bytes,19,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -215,6 +233,228 @@ func (x *Transaction) GetDescription() string {
 	return ""
 }
 
+func (x *Transaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Transaction) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Transaction) GetMcc() int32 {
+	if x != nil {
+		return x.Mcc
+	}
+	return 0
+}
+
+func (x *Transaction) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *Transaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Transaction) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *Transaction) GetDebitAmount() *common.Money {
+	if x != nil {
+		return x.DebitAmount
+	}
+	return nil
+}
+
+func (x *Transaction) GetCreditAmount() *common.Money {
+	if x != nil {
+		return x.CreditAmount
+	}
+	return nil
+}
+
+func (x *Transaction) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_wallet_wallet_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_wallet_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_wallet_wallet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Attachment) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TransactionSplit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Mcc           int32                  `protobuf:"varint,2,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionSplit) Reset() {
+	*x = TransactionSplit{}
+	mi := &file_wallet_wallet_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSplit) ProtoMessage() {}
+
+func (x *TransactionSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_wallet_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSplit.ProtoReflect.Descriptor instead.
+func (*TransactionSplit) Descriptor() ([]byte, []int) {
+	return file_wallet_wallet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransactionSplit) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetMcc() int32 {
+	if x != nil {
+		return x.Mcc
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 type GetAccountsRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -225,7 +465,7 @@ type GetAccountsRequest struct {
 
 func (x *GetAccountsRequest) Reset() {
 	*x = GetAccountsRequest{}
-	mi := &file_wallet_wallet_proto_msgTypes[2]
+	mi := &file_wallet_wallet_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -237,7 +477,7 @@ func (x *GetAccountsRequest) String() string {
 func (*GetAccountsRequest) ProtoMessage() {}
 
 func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_wallet_proto_msgTypes[2]
+	mi := &file_wallet_wallet_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +490,7 @@ func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
 func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_wallet_wallet_proto_rawDescGZIP(), []int{2}
+	return file_wallet_wallet_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetAccountsRequest) GetUserId() string {
@@ -276,7 +516,7 @@ type GetAccountsResponse struct {
 
 func (x *GetAccountsResponse) Reset() {
 	*x = GetAccountsResponse{}
-	mi := &file_wallet_wallet_proto_msgTypes[3]
+	mi := &file_wallet_wallet_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +528,7 @@ func (x *GetAccountsResponse) String() string {
 func (*GetAccountsResponse) ProtoMessage() {}
 
 func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_wallet_proto_msgTypes[3]
+	mi := &file_wallet_wallet_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +541,7 @@ func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
 func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_wallet_wallet_proto_rawDescGZIP(), []int{3}
+	return file_wallet_wallet_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetAccountsResponse) GetAccounts() []*Account {
@@ -323,7 +563,7 @@ type GetTransactionsRequest struct {
 
 func (x *GetTransactionsRequest) Reset() {
 	*x = GetTransactionsRequest{}
-	mi := &file_wallet_wallet_proto_msgTypes[4]
+	mi := &file_wallet_wallet_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -335,7 +575,7 @@ func (x *GetTransactionsRequest) String() string {
 func (*GetTransactionsRequest) ProtoMessage() {}
 
 func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_wallet_proto_msgTypes[4]
+	mi := &file_wallet_wallet_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +588,7 @@ func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_wallet_wallet_proto_rawDescGZIP(), []int{4}
+	return file_wallet_wallet_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetTransactionsRequest) GetUserId() string {
@@ -388,7 +628,7 @@ type GetTransactionsResponse struct {
 
 func (x *GetTransactionsResponse) Reset() {
 	*x = GetTransactionsResponse{}
-	mi := &file_wallet_wallet_proto_msgTypes[5]
+	mi := &file_wallet_wallet_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +640,7 @@ func (x *GetTransactionsResponse) String() string {
 func (*GetTransactionsResponse) ProtoMessage() {}
 
 func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_wallet_proto_msgTypes[5]
+	mi := &file_wallet_wallet_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +653,7 @@ func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_wallet_wallet_proto_rawDescGZIP(), []int{5}
+	return file_wallet_wallet_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetTransactionsResponse) GetTransactions() []*Transaction {
@@ -427,7 +667,7 @@ var File_wallet_wallet_proto protoreflect.FileDescriptor
 
 const file_wallet_wallet_proto_rawDesc = "" +
 	"\n" +
-	"\x13wallet/wallet.proto\x12\x06wallet\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13common/common.proto\"\xe2\x01\n" +
+	"\x13wallet/wallet.proto\x12\x06wallet\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13common/common.proto\"\xfe\x01\n" +
 	"\aAccount\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
@@ -436,7 +676,8 @@ const file_wallet_wallet_proto_rawDesc = "" +
 	"\x04type\x18\x04 \x01(\x0e2\x13.common.AccountTypeR\x04type\x12'\n" +
 	"\abalance\x18\x05 \x01(\v2\r.common.MoneyR\abalance\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd3\x02\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\barchived\x18\a \x01(\bR\barchived\"\xd5\x05\n" +
 	"\vTransaction\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
@@ -447,7 +688,35 @@ const file_wallet_wallet_proto_rawDesc = "" +
 	"\x0ffrom_account_id\x18\x06 \x01(\tR\rfromAccountId\x12\"\n" +
 	"\rto_account_id\x18\a \x01(\tR\vtoAccountId\x12.\n" +
 	"\x04date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12 \n" +
-	"\vdescription\x18\t \x01(\tR\vdescription\"a\n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12%\n" +
+	"\x0etransaction_id\x18\n" +
+	" \x01(\tR\rtransactionId\x12\x1f\n" +
+	"\vcategory_id\x18\v \x01(\tR\n" +
+	"categoryId\x12\x10\n" +
+	"\x03mcc\x18\f \x01(\x05R\x03mcc\x120\n" +
+	"\x06splits\x18\r \x03(\v2\x18.wallet.TransactionSplitR\x06splits\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\x124\n" +
+	"\vattachments\x18\x0f \x03(\v2\x12.wallet.AttachmentR\vattachments\x12\x1f\n" +
+	"\vtransfer_id\x18\x10 \x01(\tR\n" +
+	"transferId\x120\n" +
+	"\fdebit_amount\x18\x11 \x01(\v2\r.common.MoneyR\vdebitAmount\x122\n" +
+	"\rcredit_amount\x18\x12 \x01(\v2\r.common.MoneyR\fcreditAmount\x12#\n" +
+	"\rexchange_rate\x18\x13 \x01(\tR\fexchangeRate\"\xc0\x01\n" +
+	"\n" +
+	"Attachment\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8d\x01\n" +
+	"\x10TransactionSplit\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x10\n" +
+	"\x03mcc\x18\x02 \x01(\x05R\x03mcc\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"a\n" +
 	"\x12GetAccountsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
 	"\bbackends\x18\x02 \x03(\v2\x16.common.AccountBackendR\bbackends\"B\n" +
@@ -479,42 +748,49 @@ func file_wallet_wallet_proto_rawDescGZIP() []byte {
 	return file_wallet_wallet_proto_rawDescData
 }
 
-var file_wallet_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_wallet_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_wallet_wallet_proto_goTypes = []any{
 	(*Account)(nil),                 // 0: wallet.Account
 	(*Transaction)(nil),             // 1: wallet.Transaction
-	(*GetAccountsRequest)(nil),      // 2: wallet.GetAccountsRequest
-	(*GetAccountsResponse)(nil),     // 3: wallet.GetAccountsResponse
-	(*GetTransactionsRequest)(nil),  // 4: wallet.GetTransactionsRequest
-	(*GetTransactionsResponse)(nil), // 5: wallet.GetTransactionsResponse
-	(common.AccountType)(0),         // 6: common.AccountType
-	(*common.Money)(nil),            // 7: common.Money
-	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
-	(common.TransactionType)(0),     // 9: common.TransactionType
-	(*common.AccountBackend)(nil),   // 10: common.AccountBackend
+	(*Attachment)(nil),              // 2: wallet.Attachment
+	(*TransactionSplit)(nil),        // 3: wallet.TransactionSplit
+	(*GetAccountsRequest)(nil),      // 4: wallet.GetAccountsRequest
+	(*GetAccountsResponse)(nil),     // 5: wallet.GetAccountsResponse
+	(*GetTransactionsRequest)(nil),  // 6: wallet.GetTransactionsRequest
+	(*GetTransactionsResponse)(nil), // 7: wallet.GetTransactionsResponse
+	(common.AccountType)(0),         // 8: common.AccountType
+	(*common.Money)(nil),            // 9: common.Money
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
+	(common.TransactionType)(0),     // 11: common.TransactionType
+	(*common.AccountBackend)(nil),   // 12: common.AccountBackend
 }
 var file_wallet_wallet_proto_depIdxs = []int32{
-	6,  // 0: wallet.Account.type:type_name -> common.AccountType
-	7,  // 1: wallet.Account.balance:type_name -> common.Money
-	8,  // 2: wallet.Account.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 3: wallet.Transaction.type:type_name -> common.TransactionType
-	7,  // 4: wallet.Transaction.amount:type_name -> common.Money
-	8,  // 5: wallet.Transaction.date:type_name -> google.protobuf.Timestamp
-	10, // 6: wallet.GetAccountsRequest.backends:type_name -> common.AccountBackend
-	0,  // 7: wallet.GetAccountsResponse.accounts:type_name -> wallet.Account
-	10, // 8: wallet.GetTransactionsRequest.backends:type_name -> common.AccountBackend
-	8,  // 9: wallet.GetTransactionsRequest.start_date:type_name -> google.protobuf.Timestamp
-	8,  // 10: wallet.GetTransactionsRequest.end_date:type_name -> google.protobuf.Timestamp
-	1,  // 11: wallet.GetTransactionsResponse.transactions:type_name -> wallet.Transaction
-	2,  // 12: wallet.WalletService.GetAccounts:input_type -> wallet.GetAccountsRequest
-	4,  // 13: wallet.WalletService.GetTransactions:input_type -> wallet.GetTransactionsRequest
-	3,  // 14: wallet.WalletService.GetAccounts:output_type -> wallet.GetAccountsResponse
-	5,  // 15: wallet.WalletService.GetTransactions:output_type -> wallet.GetTransactionsResponse
-	14, // [14:16] is the sub-list for method output_type
-	12, // [12:14] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	8,  // 0: wallet.Account.type:type_name -> common.AccountType
+	9,  // 1: wallet.Account.balance:type_name -> common.Money
+	10, // 2: wallet.Account.created_at:type_name -> google.protobuf.Timestamp
+	11, // 3: wallet.Transaction.type:type_name -> common.TransactionType
+	9,  // 4: wallet.Transaction.amount:type_name -> common.Money
+	10, // 5: wallet.Transaction.date:type_name -> google.protobuf.Timestamp
+	3,  // 6: wallet.Transaction.splits:type_name -> wallet.TransactionSplit
+	2,  // 7: wallet.Transaction.attachments:type_name -> wallet.Attachment
+	9,  // 8: wallet.Transaction.debit_amount:type_name -> common.Money
+	9,  // 9: wallet.Transaction.credit_amount:type_name -> common.Money
+	10, // 10: wallet.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	12, // 11: wallet.GetAccountsRequest.backends:type_name -> common.AccountBackend
+	0,  // 12: wallet.GetAccountsResponse.accounts:type_name -> wallet.Account
+	12, // 13: wallet.GetTransactionsRequest.backends:type_name -> common.AccountBackend
+	10, // 14: wallet.GetTransactionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	10, // 15: wallet.GetTransactionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	1,  // 16: wallet.GetTransactionsResponse.transactions:type_name -> wallet.Transaction
+	4,  // 17: wallet.WalletService.GetAccounts:input_type -> wallet.GetAccountsRequest
+	6,  // 18: wallet.WalletService.GetTransactions:input_type -> wallet.GetTransactionsRequest
+	5,  // 19: wallet.WalletService.GetAccounts:output_type -> wallet.GetAccountsResponse
+	7,  // 20: wallet.WalletService.GetTransactions:output_type -> wallet.GetTransactionsResponse
+	19, // [19:21] is the sub-list for method output_type
+	17, // [17:19] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_wallet_wallet_proto_init() }
@@ -528,7 +804,7 @@ func file_wallet_wallet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wallet_wallet_proto_rawDesc), len(file_wallet_wallet_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,6 +2,8 @@ package database
 
 import (
 	"backend-master/configs"
+	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
@@ -14,14 +16,35 @@ const (
 	driverName = "pgx"
 )
 
+// Querier is implemented by both *sqlx.DB and *sqlx.Tx, so repositories can
+// run the same queries inside and outside of a transaction.
+type Querier interface {
+	sqlx.ExtContext
+
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
 type DBManager interface {
 	GetDB() *sqlx.DB
+
+	// Querier returns the transaction carried by ctx, if any, or the
+	// database itself.
+	Querier(ctx context.Context) Querier
+
+	// WithinTx runs fn inside a database transaction carried by the context
+	// passed to fn. The transaction is committed if fn returns nil and rolled
+	// back otherwise. If ctx already carries a transaction, fn joins it.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+type txKey struct{}
+
 type dbManagerImpl struct {
 	DBManager
 
-	conn *sqlx.DB
+	conn   *sqlx.DB
+	logger *zap.Logger
 }
 
 func NewManager(
@@ -52,10 +75,52 @@ func NewManager(
 	nativeDB.SetMaxIdleConns(5)
 
 	return &dbManagerImpl{
-		conn: sqlx.NewDb(nativeDB, driverName),
+		conn:   sqlx.NewDb(nativeDB, driverName),
+		logger: logger,
 	}, nil
 }
 
 func (d *dbManagerImpl) GetDB() *sqlx.DB {
 	return d.conn
 }
+
+func (d *dbManagerImpl) Querier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return d.conn
+}
+
+func (d *dbManagerImpl) WithinTx(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) (err error) {
+	if _, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := d.conn.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				d.logger.Error("failed to rollback transaction", zap.Error(rbErr))
+			}
+			return
+		}
+
+		if err = tx.Commit(); err != nil {
+			err = fmt.Errorf("failed to commit transaction: %w", err)
+		}
+	}()
+
+	return fn(context.WithValue(ctx, txKey{}, tx))
+}
@@ -59,6 +59,32 @@ func (c *AnalyzerClient) GetForecast(
 	return resp, nil
 }
 
+func (c *AnalyzerClient) GetAnomalies(
+	ctx context.Context,
+	req *pb.GetAnomaliesRequest,
+) (*pb.GetAnomaliesResponse, error) {
+	resp, err := c.client.GetAnomalies(ctx, req)
+	if err != nil {
+		c.logger.Error("failed to get anomalies", zap.Error(err))
+		return nil, fmt.Errorf("failed to get anomalies: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *AnalyzerClient) GetUpcomingRecurring(
+	ctx context.Context,
+	req *pb.GetUpcomingRecurringRequest,
+) (*pb.GetUpcomingRecurringResponse, error) {
+	resp, err := c.client.GetUpcomingRecurring(ctx, req)
+	if err != nil {
+		c.logger.Error("failed to get upcoming recurring payments", zap.Error(err))
+		return nil, fmt.Errorf("failed to get upcoming recurring payments: %w", err)
+	}
+
+	return resp, nil
+}
+
 func (c *AnalyzerClient) Close() error {
 	if c.conn != nil {
 		return c.conn.Close()
@@ -0,0 +1,30 @@
+package attachment
+
+import (
+	"time"
+
+	pb "backend-master/internal/api-gen/proto/wallet"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Attachment struct {
+	ID            uuid.UUID `db:"id"`
+	TransactionID uuid.UUID `db:"transaction_id"`
+	FileName      string    `db:"file_name"`
+	ContentType   string    `db:"content_type"`
+	Size          int64     `db:"size"`
+	StorageKey    string    `db:"storage_key"` // key of the content in blob storage
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+func (a *Attachment) ToProto() *pb.Attachment {
+	return &pb.Attachment{
+		AttachmentId: a.ID.String(),
+		FileName:     a.FileName,
+		ContentType:  a.ContentType,
+		Size:         a.Size,
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+	}
+}
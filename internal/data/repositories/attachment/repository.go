@@ -0,0 +1,212 @@
+package attachment
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrAttachmentNotFound  = errors.New("attachment not found")
+	ErrTransactionNotFound = errors.New("transaction not found")
+)
+
+type AttachmentRepository interface {
+	// WithinTx runs fn in a single database transaction carried by the
+	// context passed to fn.
+	WithinTx(
+		ctx context.Context,
+		fn func(ctx context.Context) error,
+	) error
+
+	// CreateAttachment stores the metadata of a file attached to one of the
+	// user's transactions. It returns ErrTransactionNotFound when the
+	// transaction does not belong to the user.
+	CreateAttachment(
+		ctx context.Context,
+		userID uuid.UUID,
+		attachment Attachment,
+	) (*Attachment, error)
+
+	GetAttachment(
+		ctx context.Context,
+		userID uuid.UUID,
+		attachmentID uuid.UUID,
+	) (*Attachment, error)
+
+	// DeleteAttachment removes the metadata and returns it, so that the
+	// caller can remove the content from blob storage.
+	DeleteAttachment(
+		ctx context.Context,
+		userID uuid.UUID,
+		attachmentID uuid.UUID,
+	) (*Attachment, error)
+}
+
+type attachmentRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) AttachmentRepository {
+	return &attachmentRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const attachmentColumns = `
+	id,
+	transaction_id,
+	file_name,
+	content_type,
+	size,
+	storage_key,
+	created_at
+`
+
+func (repo *attachmentRepositoryImpl) WithinTx(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return repo.db.WithinTx(ctx, fn)
+}
+
+func (repo *attachmentRepositoryImpl) CreateAttachment(
+	ctx context.Context,
+	userID uuid.UUID,
+	attachment Attachment,
+) (*Attachment, error) {
+	query := `
+		INSERT INTO attachments (
+			id,
+			transaction_id,
+			file_name,
+			content_type,
+			size,
+			storage_key
+		)
+		SELECT $3, t.id, $4, $5, $6, $7
+		FROM transactions t
+		JOIN accounts a ON a.id = t.account_id
+
+		WHERE 1=1
+			AND t.id = $2
+			AND a.user_id = $1
+
+		RETURNING ` + attachmentColumns
+
+	var created Attachment
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&created,
+		query,
+		userID,
+		attachment.TransactionID,
+		attachment.ID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.StorageKey,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to attach file to transaction %s: %w",
+			attachment.TransactionID.String(),
+			err,
+		)
+	}
+
+	return &created, nil
+}
+
+func (repo *attachmentRepositoryImpl) GetAttachment(
+	ctx context.Context,
+	userID uuid.UUID,
+	attachmentID uuid.UUID,
+) (*Attachment, error) {
+	query := `
+		SELECT
+			f.id,
+			f.transaction_id,
+			f.file_name,
+			f.content_type,
+			f.size,
+			f.storage_key,
+			f.created_at
+		FROM attachments f
+		JOIN transactions t ON t.id = f.transaction_id
+		JOIN accounts a ON a.id = t.account_id
+
+		WHERE 1=1
+			AND f.id = $2
+			AND a.user_id = $1
+	`
+
+	var attachment Attachment
+	err := repo.db.Querier(ctx).GetContext(ctx, &attachment, query, userID, attachmentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to get attachment %s: %w",
+			attachmentID.String(),
+			err,
+		)
+	}
+
+	return &attachment, nil
+}
+
+func (repo *attachmentRepositoryImpl) DeleteAttachment(
+	ctx context.Context,
+	userID uuid.UUID,
+	attachmentID uuid.UUID,
+) (*Attachment, error) {
+	query := `
+		DELETE FROM attachments f
+		USING transactions t, accounts a
+
+		WHERE 1=1
+			AND t.id = f.transaction_id
+			AND a.id = t.account_id
+			AND f.id = $2
+			AND a.user_id = $1
+
+		RETURNING
+			f.id,
+			f.transaction_id,
+			f.file_name,
+			f.content_type,
+			f.size,
+			f.storage_key,
+			f.created_at
+	`
+
+	var attachment Attachment
+	err := repo.db.Querier(ctx).GetContext(ctx, &attachment, query, userID, attachmentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to delete attachment %s: %w",
+			attachmentID.String(),
+			err,
+		)
+	}
+
+	return &attachment, nil
+}
@@ -0,0 +1,83 @@
+package budget
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Budget limits the spending either in an MCC or in a category, including
+// its subcategories. Exactly one of MCC and CategoryID is set.
+type Budget struct {
+	ID          uuid.UUID     `db:"id"`
+	UserID      uuid.UUID     `db:"user_id"`
+	MCC         sql.NullInt32 `db:"mcc"`
+	CategoryID  uuid.NullUUID `db:"category_id"`
+	Period      string        `db:"period"`
+	LimitAmount int64         `db:"limit_amount"` // копейки
+	Currency    string        `db:"currency"`
+	CreatedAt   time.Time     `db:"created_at"`
+}
+
+func (b *Budget) ToProto() *masterpb.Budget {
+	return &masterpb.Budget{
+		BudgetId:   b.ID.String(),
+		UserId:     b.UserID.String(),
+		CategoryId: b.Category(),
+		Period:     PeriodDbTypeToPbType(b.Period),
+		Limit: &common.Money{
+			Amount:   b.LimitAmount,
+			Currency: b.Currency,
+		},
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+}
+
+// Category returns the category ID of the budget, or its MCC for MCC
+// budgets.
+func (b *Budget) Category() string {
+	if b.CategoryID.Valid {
+		return b.CategoryID.UUID.String()
+	}
+	return strconv.Itoa(int(b.MCC.Int32))
+}
+
+func PeriodPbTypeToDbType(pbPeriod common.TimePeriod) string {
+	switch pbPeriod {
+	case common.TimePeriod_TIME_PERIOD_DAY:
+		return "DAY"
+	case common.TimePeriod_TIME_PERIOD_WEEK:
+		return "WEEK"
+	case common.TimePeriod_TIME_PERIOD_MONTH:
+		return "MONTH"
+	case common.TimePeriod_TIME_PERIOD_QUARTER:
+		return "QUARTER"
+	case common.TimePeriod_TIME_PERIOD_YEAR:
+		return "YEAR"
+	default:
+		return ""
+	}
+}
+
+func PeriodDbTypeToPbType(dbPeriod string) common.TimePeriod {
+	switch dbPeriod {
+	case "DAY":
+		return common.TimePeriod_TIME_PERIOD_DAY
+	case "WEEK":
+		return common.TimePeriod_TIME_PERIOD_WEEK
+	case "MONTH":
+		return common.TimePeriod_TIME_PERIOD_MONTH
+	case "QUARTER":
+		return common.TimePeriod_TIME_PERIOD_QUARTER
+	case "YEAR":
+		return common.TimePeriod_TIME_PERIOD_YEAR
+	default:
+		return common.TimePeriod_TIME_PERIOD_UNSPECIFIED
+	}
+}
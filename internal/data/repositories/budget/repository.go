@@ -0,0 +1,376 @@
+package budget
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrBudgetNotFound = errors.New("budget not found")
+	ErrBudgetExists   = errors.New("budget for this category and period already exists")
+)
+
+type BudgetRepository interface {
+	CreateBudget(
+		ctx context.Context,
+		budget *Budget,
+	) (*Budget, error)
+
+	UpdateBudgetLimit(
+		ctx context.Context,
+		userID uuid.UUID,
+		budgetID uuid.UUID,
+		limitAmount int64,
+		currency string,
+	) (*Budget, error)
+
+	DeleteBudget(
+		ctx context.Context,
+		userID uuid.UUID,
+		budgetID uuid.UUID,
+	) error
+
+	GetBudgetsByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]Budget, error)
+
+	// GetBudgetsByCategory returns the budgets an expense with the MCC and
+	// category counts toward: those of the MCC and those of the category or
+	// any of its parents. Either may be unset.
+	GetBudgetsByCategory(
+		ctx context.Context,
+		userID uuid.UUID,
+		mcc sql.NullInt32,
+		categoryID uuid.NullUUID,
+	) ([]Budget, error)
+
+	// GetSpent sums the expenses of the budget's owner made in [start, end)
+	// in the budget currency and in its MCC or category, including
+	// subcategories. Split expenses count by their split lines.
+	GetSpent(
+		ctx context.Context,
+		budget *Budget,
+		start time.Time,
+		end time.Time,
+	) (int64, error)
+
+	// CreateAlert records that a threshold was crossed in a budget period and
+	// reports false if it had already been recorded.
+	CreateAlert(
+		ctx context.Context,
+		budgetID uuid.UUID,
+		periodStart time.Time,
+		threshold int,
+	) (bool, error)
+}
+
+const budgetColumns = `
+	id,
+	user_id,
+	mcc,
+	category_id,
+	period,
+	limit_amount,
+	currency,
+	created_at
+`
+
+type budgetRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) BudgetRepository {
+	return &budgetRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *budgetRepositoryImpl) CreateBudget(
+	ctx context.Context,
+	budget *Budget,
+) (*Budget, error) {
+	query := `
+		INSERT INTO budgets (
+			id,
+			user_id,
+			mcc,
+			category_id,
+			period,
+			limit_amount,
+			currency,
+			created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + budgetColumns
+
+	var created Budget
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&created,
+		query,
+		uuid.New(),
+		budget.UserID,
+		budget.MCC,
+		budget.CategoryID,
+		budget.Period,
+		budget.LimitAmount,
+		budget.Currency,
+		budget.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			err = ErrBudgetExists
+		}
+		return nil, fmt.Errorf(
+			"failed to create budget for uid %s: %w",
+			budget.UserID.String(),
+			err,
+		)
+	}
+
+	return &created, nil
+}
+
+func (repo *budgetRepositoryImpl) UpdateBudgetLimit(
+	ctx context.Context,
+	userID uuid.UUID,
+	budgetID uuid.UUID,
+	limitAmount int64,
+	currency string,
+) (*Budget, error) {
+	query := `
+		UPDATE budgets
+		SET
+			limit_amount = $3,
+			currency = $4
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+		RETURNING ` + budgetColumns
+
+	var budget Budget
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&budget,
+		query,
+		userID,
+		budgetID,
+		limitAmount,
+		currency,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrBudgetNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to update budget %s: %w",
+			budgetID.String(),
+			err,
+		)
+	}
+
+	return &budget, nil
+}
+
+func (repo *budgetRepositoryImpl) DeleteBudget(
+	ctx context.Context,
+	userID uuid.UUID,
+	budgetID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM budgets
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, budgetID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete budget %s: %w",
+			budgetID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete budget %s: %w",
+			budgetID.String(),
+			ErrBudgetNotFound,
+		)
+	}
+
+	return nil
+}
+
+func (repo *budgetRepositoryImpl) GetBudgetsByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]Budget, error) {
+	query := `
+		SELECT ` + budgetColumns + `
+		FROM budgets
+
+		WHERE 1=1
+			AND user_id = $1
+
+		ORDER BY created_at, id
+	`
+
+	var budgets []Budget
+	err := repo.db.Querier(ctx).SelectContext(ctx, &budgets, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get budgets for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return budgets, nil
+}
+
+func (repo *budgetRepositoryImpl) GetBudgetsByCategory(
+	ctx context.Context,
+	userID uuid.UUID,
+	mcc sql.NullInt32,
+	categoryID uuid.NullUUID,
+) ([]Budget, error) {
+	query := `
+		WITH RECURSIVE parents AS (
+			SELECT id, parent_id FROM categories WHERE id = $3
+			UNION
+			SELECT c.id, c.parent_id FROM categories c JOIN parents p ON c.id = p.parent_id
+		)
+		SELECT ` + budgetColumns + `
+		FROM budgets
+
+		WHERE 1=1
+			AND user_id = $1
+			AND (
+				mcc = $2
+				OR category_id IN (SELECT id FROM parents)
+			)
+	`
+
+	var budgets []Budget
+	err := repo.db.Querier(ctx).SelectContext(ctx, &budgets, query, userID, mcc, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get budgets for uid %s and category %v/%v: %w",
+			userID.String(),
+			mcc,
+			categoryID,
+			err,
+		)
+	}
+
+	return budgets, nil
+}
+
+func (repo *budgetRepositoryImpl) GetSpent(
+	ctx context.Context,
+	budget *Budget,
+	start time.Time,
+	end time.Time,
+) (int64, error) {
+	query := `
+		WITH RECURSIVE sub AS (
+			SELECT id FROM categories WHERE id = $3
+			UNION
+			SELECT c.id FROM categories c JOIN sub ON c.parent_id = sub.id
+		)
+		SELECT COALESCE(SUM(COALESCE(s.amount, t.amount)), 0)
+		FROM transactions t
+		JOIN accounts a ON a.id = t.account_id
+		LEFT JOIN transaction_splits s ON s.transaction_id = t.id
+
+		WHERE 1=1
+			AND a.user_id = $1
+			AND t.type = 'EXPENSE'
+			AND t.currency = $4
+			AND t.created_at >= $5
+			AND t.created_at < $6
+			AND (
+				-- a split transaction counts by the MCCs and categories of
+				-- its splits
+				CASE WHEN s.id IS NULL THEN t.mcc ELSE s.mcc END = $2
+				OR CASE WHEN s.id IS NULL THEN t.category_id ELSE s.category_id END IN (SELECT id FROM sub)
+			)
+	`
+
+	var spent int64
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&spent,
+		query,
+		budget.UserID,
+		budget.MCC,
+		budget.CategoryID,
+		budget.Currency,
+		start,
+		end,
+	)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to sum expenses for budget %s: %w",
+			budget.ID.String(),
+			err,
+		)
+	}
+
+	return spent, nil
+}
+
+func (repo *budgetRepositoryImpl) CreateAlert(
+	ctx context.Context,
+	budgetID uuid.UUID,
+	periodStart time.Time,
+	threshold int,
+) (bool, error) {
+	query := `
+		INSERT INTO budget_alerts (
+			budget_id,
+			period_start,
+			threshold
+		)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, budgetID, periodStart, threshold)
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to record alert for budget %s: %w",
+			budgetID.String(),
+			err,
+		)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to record alert for budget %s: %w",
+			budgetID.String(),
+			err,
+		)
+	}
+
+	return rows > 0, nil
+}
@@ -0,0 +1,42 @@
+package category
+
+import (
+	"time"
+
+	masterpb "backend-master/internal/api-gen/proto/master"
+
+	"github.com/google/uuid"
+)
+
+type Category struct {
+	ID        uuid.UUID     `db:"id"`
+	UserID    uuid.NullUUID `db:"user_id"` // NULL for system categories
+	ParentID  uuid.NullUUID `db:"parent_id"`
+	Name      string        `db:"name"`
+	Icon      string        `db:"icon"`
+	Color     string        `db:"color"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+func (c *Category) IsSystem() bool {
+	return !c.UserID.Valid
+}
+
+func (c *Category) ToProto() *masterpb.Category {
+	pbCategory := &masterpb.Category{
+		CategoryId: c.ID.String(),
+		Name:       c.Name,
+		Icon:       c.Icon,
+		Color:      c.Color,
+		System:     c.IsSystem(),
+	}
+
+	if c.UserID.Valid {
+		pbCategory.UserId = c.UserID.UUID.String()
+	}
+	if c.ParentID.Valid {
+		pbCategory.ParentId = c.ParentID.UUID.String()
+	}
+
+	return pbCategory
+}
@@ -0,0 +1,272 @@
+package category
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+)
+
+type CategoryRepository interface {
+	// GetCategories returns the system categories together with the user's
+	// own ones.
+	GetCategories(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]Category, error)
+
+	// GetCategory returns a system category or one of the user's categories.
+	GetCategory(
+		ctx context.Context,
+		userID uuid.UUID,
+		categoryID uuid.UUID,
+	) (*Category, error)
+
+	CreateCategory(
+		ctx context.Context,
+		category *Category,
+	) (*Category, error)
+
+	// UpdateCategory updates one of the user's categories, system
+	// categories are never changed.
+	UpdateCategory(
+		ctx context.Context,
+		category *Category,
+	) (*Category, error)
+
+	// DeleteCategory deletes one of the user's categories and moves its
+	// children to its parent. Transactions in the category are left
+	// uncategorized.
+	DeleteCategory(
+		ctx context.Context,
+		userID uuid.UUID,
+		categoryID uuid.UUID,
+	) error
+}
+
+const categoryColumns = `
+	id,
+	user_id,
+	parent_id,
+	name,
+	icon,
+	color,
+	created_at
+`
+
+type categoryRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) CategoryRepository {
+	return &categoryRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *categoryRepositoryImpl) GetCategories(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+
+		WHERE 1=1
+			AND (user_id IS NULL OR user_id = $1)
+
+		ORDER BY user_id NULLS FIRST, name, id
+	`
+
+	var categories []Category
+	err := repo.db.Querier(ctx).SelectContext(ctx, &categories, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get categories for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return categories, nil
+}
+
+func (repo *categoryRepositoryImpl) GetCategory(
+	ctx context.Context,
+	userID uuid.UUID,
+	categoryID uuid.UUID,
+) (*Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+
+		WHERE 1=1
+			AND id = $2
+			AND (user_id IS NULL OR user_id = $1)
+	`
+
+	var category Category
+	err := repo.db.Querier(ctx).GetContext(ctx, &category, query, userID, categoryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to get category %s: %w",
+			categoryID.String(),
+			err,
+		)
+	}
+
+	return &category, nil
+}
+
+func (repo *categoryRepositoryImpl) CreateCategory(
+	ctx context.Context,
+	category *Category,
+) (*Category, error) {
+	query := `
+		INSERT INTO categories (
+			id,
+			user_id,
+			parent_id,
+			name,
+			icon,
+			color,
+			created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + categoryColumns
+
+	var created Category
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&created,
+		query,
+		uuid.New(),
+		category.UserID,
+		category.ParentID,
+		category.Name,
+		category.Icon,
+		category.Color,
+		category.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create category for uid %s: %w",
+			category.UserID.UUID.String(),
+			err,
+		)
+	}
+
+	return &created, nil
+}
+
+func (repo *categoryRepositoryImpl) UpdateCategory(
+	ctx context.Context,
+	category *Category,
+) (*Category, error) {
+	query := `
+		UPDATE categories
+		SET
+			parent_id = $3,
+			name = $4,
+			icon = $5,
+			color = $6
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+		RETURNING ` + categoryColumns
+
+	var updated Category
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&updated,
+		query,
+		category.UserID,
+		category.ID,
+		category.ParentID,
+		category.Name,
+		category.Icon,
+		category.Color,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to update category %s: %w",
+			category.ID.String(),
+			err,
+		)
+	}
+
+	return &updated, nil
+}
+
+func (repo *categoryRepositoryImpl) DeleteCategory(
+	ctx context.Context,
+	userID uuid.UUID,
+	categoryID uuid.UUID,
+) error {
+	reparentQuery := `
+		UPDATE categories c
+		SET parent_id = d.parent_id
+		FROM categories d
+
+		WHERE 1=1
+			AND d.id = $2
+			AND d.user_id = $1
+			AND c.parent_id = d.id
+	`
+
+	deleteQuery := `
+		DELETE FROM categories
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+	`
+
+	return repo.db.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := repo.db.Querier(ctx).ExecContext(ctx, reparentQuery, userID, categoryID)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to reparent children of category %s: %w",
+				categoryID.String(),
+				err,
+			)
+		}
+
+		res, err := repo.db.Querier(ctx).ExecContext(ctx, deleteQuery, userID, categoryID)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to delete category %s: %w",
+				categoryID.String(),
+				err,
+			)
+		}
+
+		if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+			return fmt.Errorf(
+				"failed to delete category %s: %w",
+				categoryID.String(),
+				ErrCategoryNotFound,
+			)
+		}
+
+		return nil
+	})
+}
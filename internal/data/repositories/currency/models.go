@@ -0,0 +1,46 @@
+package currency
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Rate says that one unit of From costs Value units of To on Date.
+type Rate struct {
+	From  string
+	To    string
+	Value *big.Rat
+	Date  time.Time
+}
+
+// Inverse returns the To -> From rate for the same date.
+func (r *Rate) Inverse() *Rate {
+	return &Rate{
+		From:  r.To,
+		To:    r.From,
+		Value: new(big.Rat).Inv(r.Value),
+		Date:  r.Date,
+	}
+}
+
+type exchangeRate struct {
+	BaseCurrency  string    `db:"base_currency"`
+	QuoteCurrency string    `db:"quote_currency"`
+	Rate          string    `db:"rate"`
+	RateDate      time.Time `db:"rate_date"`
+}
+
+func (r *exchangeRate) toRate() (*Rate, error) {
+	value, ok := new(big.Rat).SetString(r.Rate)
+	if !ok || value.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid exchange rate %q", r.Rate)
+	}
+
+	return &Rate{
+		From:  r.BaseCurrency,
+		To:    r.QuoteCurrency,
+		Value: value,
+		Date:  r.RateDate,
+	}, nil
+}
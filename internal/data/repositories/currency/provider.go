@@ -0,0 +1,54 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrRateNotFound = errors.New("exchange rate not found")
+)
+
+// RateProvider returns the latest known rate published on or before date.
+// Implementations return ErrRateNotFound when they have no such rate.
+type RateProvider interface {
+	GetRate(
+		ctx context.Context,
+		from string,
+		to string,
+		date time.Time,
+	) (*Rate, error)
+}
+
+type chainProvider struct {
+	providers []RateProvider
+}
+
+// NewChainProvider asks the providers in order and returns the first rate
+// found.
+func NewChainProvider(providers ...RateProvider) RateProvider {
+	return &chainProvider{
+		providers: providers,
+	}
+}
+
+func (p *chainProvider) GetRate(
+	ctx context.Context,
+	from string,
+	to string,
+	date time.Time,
+) (*Rate, error) {
+	for _, provider := range p.providers {
+		rate, err := provider.GetRate(ctx, from, to, date)
+		if errors.Is(err, ErrRateNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return rate, nil
+	}
+
+	return nil, ErrRateNotFound
+}
@@ -0,0 +1,86 @@
+package currency
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// CurrencyRepository serves the exchange rates kept in Postgres as a
+// RateProvider. Both the direct and the inverse quote are looked up. The
+// service only reads the table, rates are loaded into it from outside.
+type CurrencyRepository interface {
+	RateProvider
+}
+
+type currencyRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) CurrencyRepository {
+	return &currencyRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *currencyRepositoryImpl) GetRate(
+	ctx context.Context,
+	from string,
+	to string,
+	date time.Time,
+) (*Rate, error) {
+	query := `
+		SELECT
+			base_currency,
+			quote_currency,
+			rate::text AS rate,
+			rate_date
+
+		FROM exchange_rates
+
+		WHERE 1=1
+			AND (
+				(base_currency = $1 AND quote_currency = $2)
+				OR (base_currency = $2 AND quote_currency = $1)
+			)
+			AND rate_date <= $3::date
+
+		ORDER BY rate_date DESC, base_currency = $1 DESC
+		LIMIT 1
+	`
+
+	var rows []exchangeRate
+	err := repo.db.Querier(ctx).SelectContext(ctx, &rows, query, from, to, date)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get exchange rate %s/%s for %s: %w",
+			from,
+			to,
+			date.Format(rateDateLayout),
+			err,
+		)
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrRateNotFound
+	}
+
+	rate, err := rows[0].toRate()
+	if err != nil {
+		return nil, err
+	}
+
+	if rate.From != from {
+		rate = rate.Inverse()
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,117 @@
+package currency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	rateDateLayout = "2006-01-02"
+)
+
+// ratesFile is the format read by NewFileProvider, e.g.
+//
+//	{"base": "RUB", "date": "2025-11-01", "rates": {"USD": "81.5", "EUR": 94.1}}
+//
+// where each rate is the price of one unit of the currency in base.
+type ratesFile struct {
+	Base  string                 `json:"base"`
+	Date  string                 `json:"date"`
+	Rates map[string]json.Number `json:"rates"`
+}
+
+type staticProvider struct {
+	base  string
+	date  time.Time
+	rates map[string]*big.Rat
+}
+
+// NewStaticProvider serves fixed rates quoted against base, valid from date
+// onwards. It is meant for offline use and tests.
+func NewStaticProvider(
+	base string,
+	date time.Time,
+	rates map[string]*big.Rat,
+) RateProvider {
+	normalized := make(map[string]*big.Rat, len(rates))
+	for code, rate := range rates {
+		normalized[strings.ToUpper(code)] = rate
+	}
+
+	return &staticProvider{
+		base:  strings.ToUpper(base),
+		date:  date,
+		rates: normalized,
+	}
+}
+
+// NewFileProvider loads a static provider from a JSON rates file.
+func NewFileProvider(path string) (RateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rates file %s: %w", path, err)
+	}
+
+	var file ratesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse rates file %s: %w", path, err)
+	}
+
+	date, err := time.Parse(rateDateLayout, file.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date in rates file %s: %w", path, err)
+	}
+
+	rates := make(map[string]*big.Rat, len(file.Rates))
+	for code, value := range file.Rates {
+		rate, ok := new(big.Rat).SetString(value.String())
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for %s in rates file %s", value, code, path)
+		}
+		rates[code] = rate
+	}
+
+	return NewStaticProvider(file.Base, date, rates), nil
+}
+
+func (p *staticProvider) GetRate(
+	ctx context.Context,
+	from string,
+	to string,
+	date time.Time,
+) (*Rate, error) {
+	if date.Before(p.date) {
+		return nil, ErrRateNotFound
+	}
+
+	fromRate, ok := p.priceInBase(from)
+	if !ok {
+		return nil, ErrRateNotFound
+	}
+
+	toRate, ok := p.priceInBase(to)
+	if !ok {
+		return nil, ErrRateNotFound
+	}
+
+	return &Rate{
+		From:  from,
+		To:    to,
+		Value: new(big.Rat).Quo(fromRate, toRate),
+		Date:  p.date,
+	}, nil
+}
+
+func (p *staticProvider) priceInBase(code string) (*big.Rat, bool) {
+	if code == p.base {
+		return big.NewRat(1, 1), true
+	}
+
+	rate, ok := p.rates[code]
+	return rate, ok
+}
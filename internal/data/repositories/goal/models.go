@@ -0,0 +1,46 @@
+package goal
+
+import (
+	"database/sql"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Goal struct {
+	ID           uuid.UUID     `db:"id"`
+	UserID       uuid.UUID     `db:"user_id"`
+	Name         string        `db:"name"`
+	TargetAmount int64         `db:"target_amount"` // копейки
+	Currency     string        `db:"currency"`
+	Deadline     sql.NullTime  `db:"deadline"`
+	AccountID    uuid.NullUUID `db:"account_id"`
+	CreatedAt    time.Time     `db:"created_at"`
+}
+
+func (g *Goal) ToProto() *masterpb.Goal {
+	pbGoal := &masterpb.Goal{
+		GoalId: g.ID.String(),
+		UserId: g.UserID.String(),
+		Name:   g.Name,
+		Target: &common.Money{
+			Amount:   g.TargetAmount,
+			Currency: g.Currency,
+		},
+		CreatedAt: timestamppb.New(g.CreatedAt),
+	}
+
+	if g.Deadline.Valid {
+		pbGoal.Deadline = timestamppb.New(g.Deadline.Time)
+	}
+
+	if g.AccountID.Valid {
+		pbGoal.AccountId = g.AccountID.UUID.String()
+	}
+
+	return pbGoal
+}
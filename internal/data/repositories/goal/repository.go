@@ -0,0 +1,380 @@
+package goal
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrGoalNotFound         = errors.New("goal not found")
+	ErrContributionNotFound = errors.New("goal contribution not found")
+	ErrContributionExists   = errors.New("transaction is already a contribution to this goal")
+	ErrContributionTarget   = errors.New("goal or transaction not found")
+	ErrContributionCurrency = errors.New("contribution must be in the goal currency")
+)
+
+type GoalRepository interface {
+	CreateGoal(
+		ctx context.Context,
+		goal *Goal,
+	) (*Goal, error)
+
+	UpdateGoal(
+		ctx context.Context,
+		goal *Goal,
+	) (*Goal, error)
+
+	DeleteGoal(
+		ctx context.Context,
+		userID uuid.UUID,
+		goalID uuid.UUID,
+	) error
+
+	GetGoalsByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]Goal, error)
+
+	// AddContribution tags one of the user's transactions as a contribution
+	// to one of the user's goals. The transaction must be in the goal
+	// currency.
+	AddContribution(
+		ctx context.Context,
+		userID uuid.UUID,
+		goalID uuid.UUID,
+		transactionID uuid.UUID,
+	) error
+
+	RemoveContribution(
+		ctx context.Context,
+		userID uuid.UUID,
+		goalID uuid.UUID,
+		transactionID uuid.UUID,
+	) error
+
+	// SumContributions sums the amounts of the goal's contributions made in
+	// the given currency.
+	SumContributions(
+		ctx context.Context,
+		goalID uuid.UUID,
+		currency string,
+	) (int64, error)
+}
+
+const goalColumns = `
+	id,
+	user_id,
+	name,
+	target_amount,
+	currency,
+	deadline,
+	account_id,
+	created_at
+`
+
+type goalRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) GoalRepository {
+	return &goalRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *goalRepositoryImpl) CreateGoal(
+	ctx context.Context,
+	goal *Goal,
+) (*Goal, error) {
+	query := `
+		INSERT INTO goals (
+			id,
+			user_id,
+			name,
+			target_amount,
+			currency,
+			deadline,
+			account_id,
+			created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + goalColumns
+
+	var created Goal
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&created,
+		query,
+		uuid.New(),
+		goal.UserID,
+		goal.Name,
+		goal.TargetAmount,
+		goal.Currency,
+		goal.Deadline,
+		goal.AccountID,
+		goal.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create goal for uid %s: %w",
+			goal.UserID.String(),
+			err,
+		)
+	}
+
+	return &created, nil
+}
+
+func (repo *goalRepositoryImpl) UpdateGoal(
+	ctx context.Context,
+	goal *Goal,
+) (*Goal, error) {
+	query := `
+		UPDATE goals
+		SET
+			name = $3,
+			target_amount = $4,
+			currency = $5,
+			deadline = $6,
+			account_id = $7
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+		RETURNING ` + goalColumns
+
+	var updated Goal
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&updated,
+		query,
+		goal.UserID,
+		goal.ID,
+		goal.Name,
+		goal.TargetAmount,
+		goal.Currency,
+		goal.Deadline,
+		goal.AccountID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrGoalNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to update goal %s: %w",
+			goal.ID.String(),
+			err,
+		)
+	}
+
+	return &updated, nil
+}
+
+func (repo *goalRepositoryImpl) DeleteGoal(
+	ctx context.Context,
+	userID uuid.UUID,
+	goalID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM goals
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, goalID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete goal %s: %w",
+			goalID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete goal %s: %w",
+			goalID.String(),
+			ErrGoalNotFound,
+		)
+	}
+
+	return nil
+}
+
+func (repo *goalRepositoryImpl) GetGoalsByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]Goal, error) {
+	query := `
+		SELECT ` + goalColumns + `
+		FROM goals
+
+		WHERE 1=1
+			AND user_id = $1
+
+		ORDER BY created_at, id
+	`
+
+	var goals []Goal
+	err := repo.db.Querier(ctx).SelectContext(ctx, &goals, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get goals for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return goals, nil
+}
+
+func (repo *goalRepositoryImpl) AddContribution(
+	ctx context.Context,
+	userID uuid.UUID,
+	goalID uuid.UUID,
+	transactionID uuid.UUID,
+) error {
+	checkQuery := `
+		SELECT t.currency = g.currency
+		FROM goals g, transactions t
+		JOIN accounts a ON a.id = t.account_id
+
+		WHERE 1=1
+			AND g.id = $2
+			AND g.user_id = $1
+			AND t.id = $3
+			AND a.user_id = $1
+	`
+
+	query := `
+		INSERT INTO goal_contributions (
+			goal_id,
+			transaction_id
+		)
+		SELECT g.id, t.id
+		FROM goals g, transactions t
+		JOIN accounts a ON a.id = t.account_id
+
+		WHERE 1=1
+			AND g.id = $2
+			AND g.user_id = $1
+			AND t.id = $3
+			AND a.user_id = $1
+	`
+
+	var sameCurrency bool
+	err := repo.db.Querier(ctx).GetContext(ctx, &sameCurrency, checkQuery, userID, goalID, transactionID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = ErrContributionTarget
+	case err == nil && !sameCurrency:
+		err = ErrContributionCurrency
+	}
+	if err != nil {
+		return fmt.Errorf(
+			"failed to add contribution to goal %s: %w",
+			goalID.String(),
+			err,
+		)
+	}
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, goalID, transactionID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			err = ErrContributionExists
+		}
+		return fmt.Errorf(
+			"failed to add contribution to goal %s: %w",
+			goalID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to add contribution to goal %s: %w",
+			goalID.String(),
+			ErrContributionTarget,
+		)
+	}
+
+	return nil
+}
+
+func (repo *goalRepositoryImpl) RemoveContribution(
+	ctx context.Context,
+	userID uuid.UUID,
+	goalID uuid.UUID,
+	transactionID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM goal_contributions c
+		USING goals g
+
+		WHERE 1=1
+			AND g.id = c.goal_id
+			AND g.user_id = $1
+			AND c.goal_id = $2
+			AND c.transaction_id = $3
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, goalID, transactionID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to remove contribution from goal %s: %w",
+			goalID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to remove contribution from goal %s: %w",
+			goalID.String(),
+			ErrContributionNotFound,
+		)
+	}
+
+	return nil
+}
+
+func (repo *goalRepositoryImpl) SumContributions(
+	ctx context.Context,
+	goalID uuid.UUID,
+	currency string,
+) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(t.amount), 0)
+		FROM goal_contributions c
+		JOIN transactions t ON t.id = c.transaction_id
+
+		WHERE 1=1
+			AND c.goal_id = $1
+			AND t.currency = $2
+	`
+
+	var sum int64
+	err := repo.db.Querier(ctx).GetContext(ctx, &sum, query, goalID, currency)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to sum contributions for goal %s: %w",
+			goalID.String(),
+			err,
+		)
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,83 @@
+package imports
+
+import (
+	"database/sql"
+	"time"
+
+	masterpb "backend-master/internal/api-gen/proto/master"
+
+	"github.com/google/uuid"
+)
+
+// Profile maps the columns of a bank's CSV statement to transaction fields.
+// Column mappings name a header or hold a 1-based column number.
+type Profile struct {
+	ID               uuid.UUID `db:"id"`
+	UserID           uuid.UUID `db:"user_id"`
+	Name             string    `db:"name"`
+	Delimiter        string    `db:"delimiter"`
+	Encoding         string    `db:"encoding"`
+	DateFormat       string    `db:"date_format"`
+	DecimalSeparator string    `db:"decimal_separator"`
+	SkipRows         int32     `db:"skip_rows"`
+	HasHeader        bool      `db:"has_header"`
+	SignConvention   string    `db:"sign_convention"`
+
+	DateColumn        string         `db:"date_column"`
+	AmountColumn      sql.NullString `db:"amount_column"`
+	DebitColumn       sql.NullString `db:"debit_column"`
+	CreditColumn      sql.NullString `db:"credit_column"`
+	DescriptionColumn sql.NullString `db:"description_column"`
+	MCCColumn         sql.NullString `db:"mcc_column"`
+	CurrencyColumn    sql.NullString `db:"currency_column"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (p *Profile) ToProto() *masterpb.ImportProfile {
+	return &masterpb.ImportProfile{
+		ProfileId:         p.ID.String(),
+		UserId:            p.UserID.String(),
+		Name:              p.Name,
+		Delimiter:         p.Delimiter,
+		Encoding:          p.Encoding,
+		DateFormat:        p.DateFormat,
+		DecimalSeparator:  p.DecimalSeparator,
+		SkipRows:          p.SkipRows,
+		HasHeader:         &p.HasHeader,
+		SignConvention:    SignConventionDbTypeToPbType(p.SignConvention),
+		DateColumn:        p.DateColumn,
+		AmountColumn:      p.AmountColumn.String,
+		DebitColumn:       p.DebitColumn.String,
+		CreditColumn:      p.CreditColumn.String,
+		DescriptionColumn: p.DescriptionColumn.String,
+		MccColumn:         p.MCCColumn.String,
+		CurrencyColumn:    p.CurrencyColumn.String,
+	}
+}
+
+func SignConventionPbTypeToDbType(pbSign masterpb.ImportSignConvention) string {
+	switch pbSign {
+	case masterpb.ImportSignConvention_IMPORT_SIGN_CONVENTION_NEGATIVE_EXPENSE:
+		return "NEGATIVE_EXPENSE"
+	case masterpb.ImportSignConvention_IMPORT_SIGN_CONVENTION_POSITIVE_EXPENSE:
+		return "POSITIVE_EXPENSE"
+	case masterpb.ImportSignConvention_IMPORT_SIGN_CONVENTION_DEBIT_CREDIT:
+		return "DEBIT_CREDIT"
+	default:
+		return ""
+	}
+}
+
+func SignConventionDbTypeToPbType(dbSign string) masterpb.ImportSignConvention {
+	switch dbSign {
+	case "NEGATIVE_EXPENSE":
+		return masterpb.ImportSignConvention_IMPORT_SIGN_CONVENTION_NEGATIVE_EXPENSE
+	case "POSITIVE_EXPENSE":
+		return masterpb.ImportSignConvention_IMPORT_SIGN_CONVENTION_POSITIVE_EXPENSE
+	case "DEBIT_CREDIT":
+		return masterpb.ImportSignConvention_IMPORT_SIGN_CONVENTION_DEBIT_CREDIT
+	default:
+		return masterpb.ImportSignConvention_IMPORT_SIGN_CONVENTION_UNSPECIFIED
+	}
+}
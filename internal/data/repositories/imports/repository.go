@@ -0,0 +1,305 @@
+package imports
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrProfileNotFound = errors.New("import profile not found")
+	ErrProfileExists   = errors.New("import profile with this name already exists")
+)
+
+type ImportRepository interface {
+	GetProfiles(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]Profile, error)
+
+	GetProfile(
+		ctx context.Context,
+		userID uuid.UUID,
+		profileID uuid.UUID,
+	) (*Profile, error)
+
+	CreateProfile(
+		ctx context.Context,
+		profile *Profile,
+	) (*Profile, error)
+
+	UpdateProfile(
+		ctx context.Context,
+		profile *Profile,
+	) (*Profile, error)
+
+	DeleteProfile(
+		ctx context.Context,
+		userID uuid.UUID,
+		profileID uuid.UUID,
+	) error
+}
+
+const profileColumns = `
+	id,
+	user_id,
+	name,
+	delimiter,
+	encoding,
+	date_format,
+	decimal_separator,
+	skip_rows,
+	has_header,
+	sign_convention,
+	date_column,
+	amount_column,
+	debit_column,
+	credit_column,
+	description_column,
+	mcc_column,
+	currency_column,
+	created_at
+`
+
+type importRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) ImportRepository {
+	return &importRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *importRepositoryImpl) GetProfiles(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]Profile, error) {
+	query := `
+		SELECT ` + profileColumns + `
+		FROM import_profiles
+
+		WHERE 1=1
+			AND user_id = $1
+
+		ORDER BY name
+	`
+
+	var profiles []Profile
+	err := repo.db.Querier(ctx).SelectContext(ctx, &profiles, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get import profiles for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return profiles, nil
+}
+
+func (repo *importRepositoryImpl) GetProfile(
+	ctx context.Context,
+	userID uuid.UUID,
+	profileID uuid.UUID,
+) (*Profile, error) {
+	query := `
+		SELECT ` + profileColumns + `
+		FROM import_profiles
+
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+	`
+
+	var profile Profile
+	err := repo.db.Querier(ctx).GetContext(ctx, &profile, query, userID, profileID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrProfileNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to get import profile %s: %w",
+			profileID.String(),
+			err,
+		)
+	}
+
+	return &profile, nil
+}
+
+func (repo *importRepositoryImpl) CreateProfile(
+	ctx context.Context,
+	profile *Profile,
+) (*Profile, error) {
+	query := `
+		INSERT INTO import_profiles (
+			id,
+			user_id,
+			name,
+			delimiter,
+			encoding,
+			date_format,
+			decimal_separator,
+			skip_rows,
+			has_header,
+			sign_convention,
+			date_column,
+			amount_column,
+			debit_column,
+			credit_column,
+			description_column,
+			mcc_column,
+			currency_column
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		RETURNING ` + profileColumns
+
+	var created Profile
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&created,
+		query,
+		uuid.New(),
+		profile.UserID,
+		profile.Name,
+		profile.Delimiter,
+		profile.Encoding,
+		profile.DateFormat,
+		profile.DecimalSeparator,
+		profile.SkipRows,
+		profile.HasHeader,
+		profile.SignConvention,
+		profile.DateColumn,
+		profile.AmountColumn,
+		profile.DebitColumn,
+		profile.CreditColumn,
+		profile.DescriptionColumn,
+		profile.MCCColumn,
+		profile.CurrencyColumn,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			err = ErrProfileExists
+		}
+		return nil, fmt.Errorf(
+			"failed to create import profile for uid %s: %w",
+			profile.UserID.String(),
+			err,
+		)
+	}
+
+	return &created, nil
+}
+
+func (repo *importRepositoryImpl) UpdateProfile(
+	ctx context.Context,
+	profile *Profile,
+) (*Profile, error) {
+	query := `
+		UPDATE import_profiles
+		SET
+			name = $3,
+			delimiter = $4,
+			encoding = $5,
+			date_format = $6,
+			decimal_separator = $7,
+			skip_rows = $8,
+			has_header = $9,
+			sign_convention = $10,
+			date_column = $11,
+			amount_column = $12,
+			debit_column = $13,
+			credit_column = $14,
+			description_column = $15,
+			mcc_column = $16,
+			currency_column = $17
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+		RETURNING ` + profileColumns
+
+	var updated Profile
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&updated,
+		query,
+		profile.UserID,
+		profile.ID,
+		profile.Name,
+		profile.Delimiter,
+		profile.Encoding,
+		profile.DateFormat,
+		profile.DecimalSeparator,
+		profile.SkipRows,
+		profile.HasHeader,
+		profile.SignConvention,
+		profile.DateColumn,
+		profile.AmountColumn,
+		profile.DebitColumn,
+		profile.CreditColumn,
+		profile.DescriptionColumn,
+		profile.MCCColumn,
+		profile.CurrencyColumn,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			err = ErrProfileNotFound
+		case errors.As(err, &pgErr) && pgErr.Code == "23505":
+			err = ErrProfileExists
+		}
+		return nil, fmt.Errorf(
+			"failed to update import profile %s: %w",
+			profile.ID.String(),
+			err,
+		)
+	}
+
+	return &updated, nil
+}
+
+func (repo *importRepositoryImpl) DeleteProfile(
+	ctx context.Context,
+	userID uuid.UUID,
+	profileID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM import_profiles
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, profileID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete import profile %s: %w",
+			profileID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete import profile %s: %w",
+			profileID.String(),
+			ErrProfileNotFound,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package market
+
+import (
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BrokerLink holds the broker credentials of an INVESTMENT account. Token is
+// kept in plain text in memory only; the repository encrypts it at rest.
+type BrokerLink struct {
+	AccountID         uuid.UUID `db:"account_id"`
+	UserID            uuid.UUID `db:"user_id"`
+	BackendType       string    `db:"backend_type"`
+	ExternalAccountID string    `db:"external_account_id"`
+	Token             string    `db:"-"`
+	TokenEncrypted    []byte    `db:"token_encrypted"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
+
+func (l *BrokerLink) ToBackend() *common.AccountBackend {
+	return &common.AccountBackend{
+		Type:      l.BackendType,
+		AccountId: l.ExternalAccountID,
+		Token:     l.Token,
+	}
+}
+
+func (l *BrokerLink) ToProto() *masterpb.BrokerLink {
+	return &masterpb.BrokerLink{
+		AccountId:         l.AccountID.String(),
+		BackendType:       l.BackendType,
+		ExternalAccountId: l.ExternalAccountID,
+		CreatedAt:         timestamppb.New(l.CreatedAt),
+		UpdatedAt:         timestamppb.New(l.UpdatedAt),
+	}
+}
@@ -0,0 +1,184 @@
+package market
+
+import (
+	"backend-master/internal/data/database"
+	"backend-master/internal/data/secrets"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrBrokerLinkNotFound = errors.New("broker link not found")
+)
+
+type MarketRepository interface {
+	UpsertBrokerLink(
+		ctx context.Context,
+		link *BrokerLink,
+	) (*BrokerLink, error)
+
+	GetBrokerLink(
+		ctx context.Context,
+		accountID uuid.UUID,
+	) (*BrokerLink, error)
+
+	// DeleteBrokerLink removes the link of the user's account.
+	DeleteBrokerLink(
+		ctx context.Context,
+		userID uuid.UUID,
+		accountID uuid.UUID,
+	) error
+}
+
+type marketRepositoryImpl struct {
+	db     database.DBManager
+	cipher secrets.Cipher
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	cipher secrets.Cipher,
+	logger *zap.Logger,
+) MarketRepository {
+	return &marketRepositoryImpl{
+		db:     db,
+		cipher: cipher,
+		logger: logger,
+	}
+}
+
+func (repo *marketRepositoryImpl) UpsertBrokerLink(
+	ctx context.Context,
+	link *BrokerLink,
+) (*BrokerLink, error) {
+	query := `
+		INSERT INTO broker_links (
+			account_id,
+			user_id,
+			backend_type,
+			external_account_id,
+			token_encrypted
+		) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (account_id) DO UPDATE SET
+			backend_type = EXCLUDED.backend_type,
+			external_account_id = EXCLUDED.external_account_id,
+			token_encrypted = EXCLUDED.token_encrypted,
+			updated_at = NOW()
+		RETURNING account_id, user_id, backend_type, external_account_id, token_encrypted, created_at, updated_at
+	`
+
+	encrypted, err := repo.cipher.Encrypt([]byte(link.Token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt broker token: %w", err)
+	}
+
+	token := link.Token
+	err = repo.db.Querier(ctx).GetContext(
+		ctx,
+		link,
+		query,
+		link.AccountID,
+		link.UserID,
+		link.BackendType,
+		link.ExternalAccountID,
+		encrypted,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to save broker link for aid %s: %w",
+			link.AccountID.String(),
+			err,
+		)
+	}
+	link.Token = token
+
+	return link, nil
+}
+
+func (repo *marketRepositoryImpl) GetBrokerLink(
+	ctx context.Context,
+	accountID uuid.UUID,
+) (*BrokerLink, error) {
+	query := `
+		SELECT
+			account_id,
+			user_id,
+			backend_type,
+			external_account_id,
+			token_encrypted,
+			created_at,
+			updated_at
+
+		FROM broker_links
+
+		WHERE 1=1
+			AND account_id = $1
+	`
+
+	var link BrokerLink
+	err := repo.db.Querier(ctx).GetContext(ctx, &link, query, accountID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(
+			"failed to get broker link for aid %s: %w",
+			accountID.String(),
+			ErrBrokerLinkNotFound,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get broker link for aid %s: %w",
+			accountID.String(),
+			err,
+		)
+	}
+
+	token, err := repo.cipher.Decrypt(link.TokenEncrypted)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to decrypt broker token for aid %s: %w",
+			accountID.String(),
+			err,
+		)
+	}
+	link.Token = string(token)
+
+	return &link, nil
+}
+
+func (repo *marketRepositoryImpl) DeleteBrokerLink(
+	ctx context.Context,
+	userID uuid.UUID,
+	accountID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM broker_links
+		WHERE 1=1
+			AND user_id = $1
+			AND account_id = $2
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, accountID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete broker link for aid %s: %w",
+			accountID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete broker link for aid %s: %w",
+			accountID.String(),
+			ErrBrokerLinkNotFound,
+		)
+	}
+
+	return nil
+}
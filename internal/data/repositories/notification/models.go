@@ -1,20 +1,34 @@
 package notification
 
 import (
+	"database/sql"
 	"time"
 
+	masterpb "backend-master/internal/api-gen/proto/master"
 	pb "backend-master/internal/api-gen/proto/notification"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	StatusPending = "PENDING"
+	StatusSent    = "SENT"
+	StatusDead    = "DEAD"
 )
 
 type Notification struct {
-	ID        uuid.UUID `db:"id"`
-	UserID    uuid.UUID `db:"user_id"`
-	Title     string    `db:"title"`
-	Message   string    `db:"message"`
-	SentAt    time.Time `db:"sent_at"`
-	CreatedAt time.Time `db:"created_at"`
+	ID            uuid.UUID      `db:"id"`
+	UserID        uuid.UUID      `db:"user_id"`
+	Title         string         `db:"title"`
+	Message       string         `db:"message"`
+	Status        string         `db:"status"`
+	Attempts      int            `db:"attempts"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	LastError     sql.NullString `db:"last_error"`
+	SentAt        sql.NullTime   `db:"sent_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+	ReadAt        sql.NullTime   `db:"read_at"`
 }
 
 func (n *Notification) ToProto() *pb.SendNotificationRequest {
@@ -25,3 +39,24 @@ func (n *Notification) ToProto() *pb.SendNotificationRequest {
 	}
 }
 
+func (n *Notification) ToMasterProto() *masterpb.Notification {
+	pbNotification := &masterpb.Notification{
+		NotificationId: n.ID.String(),
+		UserId:         n.UserID.String(),
+		Title:          n.Title,
+		Message:        n.Message,
+		CreatedAt:      timestamppb.New(n.CreatedAt),
+		Read:           n.ReadAt.Valid,
+		Status:         n.Status,
+	}
+
+	if n.SentAt.Valid {
+		pbNotification.SentAt = timestamppb.New(n.SentAt.Time)
+	}
+
+	if n.ReadAt.Valid {
+		pbNotification.ReadAt = timestamppb.New(n.ReadAt.Time)
+	}
+
+	return pbNotification
+}
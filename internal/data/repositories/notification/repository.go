@@ -3,6 +3,8 @@ package notification
 import (
 	"backend-master/internal/data/database"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,7 +12,13 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
 type NotificationRepository interface {
+	// CreateNotification stores a PENDING notification for delivery by the
+	// dispatcher. It joins the transaction carried by ctx, if any.
 	CreateNotification(
 		ctx context.Context,
 		userID uuid.UUID,
@@ -18,13 +26,81 @@ type NotificationRepository interface {
 		message string,
 	) (*Notification, error)
 
+	// GetNotificationsByUserID returns up to limit notifications, newest
+	// first, starting after the cursor when it is set.
 	GetNotificationsByUserID(
 		ctx context.Context,
 		userID uuid.UUID,
 		limit int,
+		after *database.Cursor,
+		unreadOnly bool,
 	) ([]Notification, error)
+
+	// SetNotificationsRead marks the user's notifications as read or unread
+	// and returns how many were changed.
+	SetNotificationsRead(
+		ctx context.Context,
+		userID uuid.UUID,
+		notificationIDs []uuid.UUID,
+		read bool,
+	) (int64, error)
+
+	DeleteNotification(
+		ctx context.Context,
+		userID uuid.UUID,
+		notificationID uuid.UUID,
+	) error
+
+	CountUnread(
+		ctx context.Context,
+		userID uuid.UUID,
+	) (int64, error)
+
+	// ClaimPending returns up to limit notifications that are due for
+	// delivery and postpones their next attempt by lease, so concurrent
+	// dispatchers do not pick them up while they are being delivered.
+	ClaimPending(
+		ctx context.Context,
+		limit int,
+		lease time.Duration,
+	) ([]Notification, error)
+
+	MarkSent(
+		ctx context.Context,
+		notificationID uuid.UUID,
+	) error
+
+	// MarkFailed records a failed delivery attempt. The notification is
+	// retried at retryAt, or moved to DEAD when retryAt is nil.
+	MarkFailed(
+		ctx context.Context,
+		notificationID uuid.UUID,
+		lastError string,
+		retryAt *time.Time,
+	) error
+
+	// ReleaseClaims makes claimed notifications due again without counting
+	// an attempt, e.g. when the dispatcher stops before delivering them.
+	ReleaseClaims(
+		ctx context.Context,
+		notificationIDs []uuid.UUID,
+	) error
 }
 
+const notificationColumns = `
+	id,
+	user_id,
+	title,
+	message,
+	status,
+	attempts,
+	next_attempt_at,
+	last_error,
+	sent_at,
+	created_at,
+	read_at
+`
+
 type notificationRepositoryImpl struct {
 	db     database.DBManager
 	logger *zap.Logger
@@ -52,23 +128,23 @@ func (repo *notificationRepositoryImpl) CreateNotification(
 			user_id,
 			title,
 			message,
-			sent_at,
+			status,
+			next_attempt_at,
 			created_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id, user_id, title, message, sent_at, created_at
-	`
+		VALUES ($1, $2, $3, $4, $5, $6, $6)
+		RETURNING ` + notificationColumns
 
 	notification := Notification{
 		ID:        uuid.New(),
 		UserID:    userID,
 		Title:     title,
 		Message:   message,
-		SentAt:    time.Now(),
+		Status:    StatusPending,
 		CreatedAt: time.Now(),
 	}
 
-	err := repo.db.GetDB().GetContext(
+	err := repo.db.Querier(ctx).GetContext(
 		ctx,
 		&notification,
 		query,
@@ -76,7 +152,7 @@ func (repo *notificationRepositoryImpl) CreateNotification(
 		notification.UserID,
 		notification.Title,
 		notification.Message,
-		notification.SentAt,
+		notification.Status,
 		notification.CreatedAt,
 	)
 	if err != nil {
@@ -94,27 +170,42 @@ func (repo *notificationRepositoryImpl) GetNotificationsByUserID(
 	ctx context.Context,
 	userID uuid.UUID,
 	limit int,
+	after *database.Cursor,
+	unreadOnly bool,
 ) ([]Notification, error) {
 	query := `
-		SELECT 
-			id,
-			user_id,
-			title,
-			message,
-			sent_at,
-			created_at
-
+		SELECT ` + notificationColumns + `
 		FROM notifications
 
 		WHERE 1=1
 			AND user_id = $1
+			AND (NOT $2 OR read_at IS NULL)
+			AND ($3::timestamptz IS NULL OR (created_at, id) < ($3::timestamptz, $4::uuid))
 
-		ORDER BY created_at DESC
-		LIMIT $2
+		ORDER BY created_at DESC, id DESC
+		LIMIT $5
 	`
 
+	var (
+		afterCreatedAt sql.NullTime
+		afterID        uuid.NullUUID
+	)
+	if after != nil {
+		afterCreatedAt = sql.NullTime{Time: after.CreatedAt, Valid: true}
+		afterID = uuid.NullUUID{UUID: after.ID, Valid: true}
+	}
+
 	var notifications []Notification
-	err := repo.db.GetDB().SelectContext(ctx, &notifications, query, userID, limit)
+	err := repo.db.Querier(ctx).SelectContext(
+		ctx,
+		&notifications,
+		query,
+		userID,
+		unreadOnly,
+		afterCreatedAt,
+		afterID,
+		limit,
+	)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"failed to get notifications for uid %s: %w",
@@ -125,3 +216,232 @@ func (repo *notificationRepositoryImpl) GetNotificationsByUserID(
 
 	return notifications, nil
 }
+
+func (repo *notificationRepositoryImpl) SetNotificationsRead(
+	ctx context.Context,
+	userID uuid.UUID,
+	notificationIDs []uuid.UUID,
+	read bool,
+) (int64, error) {
+	query := `
+		UPDATE notifications
+		SET read_at = CASE WHEN $3 THEN COALESCE(read_at, NOW()) ELSE NULL END
+		WHERE 1=1
+			AND user_id = $1
+			AND id = ANY($2::uuid[])
+			AND (read_at IS NULL) = $3
+	`
+
+	ids := make([]string, 0, len(notificationIDs))
+	for _, id := range notificationIDs {
+		ids = append(ids, id.String())
+	}
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, ids, read)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to mark notifications for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to mark notifications for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return updated, nil
+}
+
+func (repo *notificationRepositoryImpl) DeleteNotification(
+	ctx context.Context,
+	userID uuid.UUID,
+	notificationID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM notifications
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, notificationID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete notification %s: %w",
+			notificationID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete notification %s: %w",
+			notificationID.String(),
+			ErrNotificationNotFound,
+		)
+	}
+
+	return nil
+}
+
+func (repo *notificationRepositoryImpl) CountUnread(
+	ctx context.Context,
+	userID uuid.UUID,
+) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM notifications
+
+		WHERE 1=1
+			AND user_id = $1
+			AND read_at IS NULL
+	`
+
+	var count int64
+	err := repo.db.Querier(ctx).GetContext(ctx, &count, query, userID)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to count unread notifications for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return count, nil
+}
+
+func (repo *notificationRepositoryImpl) ClaimPending(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]Notification, error) {
+	query := `
+		UPDATE notifications
+		SET next_attempt_at = NOW() + make_interval(secs => $3)
+		WHERE id IN (
+			SELECT id
+			FROM notifications
+
+			WHERE 1=1
+				AND status = $1
+				AND next_attempt_at <= NOW()
+
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + notificationColumns
+
+	var notifications []Notification
+	err := repo.db.Querier(ctx).SelectContext(
+		ctx,
+		&notifications,
+		query,
+		StatusPending,
+		limit,
+		lease.Seconds(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (repo *notificationRepositoryImpl) MarkSent(
+	ctx context.Context,
+	notificationID uuid.UUID,
+) error {
+	query := `
+		UPDATE notifications
+		SET
+			status = $2,
+			attempts = attempts + 1,
+			last_error = NULL,
+			sent_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := repo.db.Querier(ctx).ExecContext(ctx, query, notificationID, StatusSent)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to mark notification %s as sent: %w",
+			notificationID.String(),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (repo *notificationRepositoryImpl) MarkFailed(
+	ctx context.Context,
+	notificationID uuid.UUID,
+	lastError string,
+	retryAt *time.Time,
+) error {
+	query := `
+		UPDATE notifications
+		SET
+			status = CASE WHEN $3::timestamptz IS NULL THEN $4 ELSE status END,
+			attempts = attempts + 1,
+			last_error = $2,
+			next_attempt_at = COALESCE($3::timestamptz, next_attempt_at)
+		WHERE id = $1
+	`
+
+	var next sql.NullTime
+	if retryAt != nil {
+		next = sql.NullTime{Time: *retryAt, Valid: true}
+	}
+
+	_, err := repo.db.Querier(ctx).ExecContext(
+		ctx,
+		query,
+		notificationID,
+		lastError,
+		next,
+		StatusDead,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to record delivery failure for notification %s: %w",
+			notificationID.String(),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (repo *notificationRepositoryImpl) ReleaseClaims(
+	ctx context.Context,
+	notificationIDs []uuid.UUID,
+) error {
+	query := `
+		UPDATE notifications
+		SET next_attempt_at = NOW()
+
+		WHERE 1=1
+			AND id = ANY($1::uuid[])
+			AND status = $2
+	`
+
+	ids := make([]string, 0, len(notificationIDs))
+	for _, id := range notificationIDs {
+		ids = append(ids, id.String())
+	}
+
+	_, err := repo.db.Querier(ctx).ExecContext(ctx, query, ids, StatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to release claimed notifications: %w", err)
+	}
+
+	return nil
+}
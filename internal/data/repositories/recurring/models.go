@@ -0,0 +1,111 @@
+package recurring
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/repositories/wallet"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Recurring is a template of a transaction repeated on a schedule.
+type Recurring struct {
+	ID          uuid.UUID      `db:"id"`
+	UserID      uuid.UUID      `db:"user_id"`
+	AccountID   uuid.UUID      `db:"account_id"`
+	ToAccountID uuid.NullUUID  `db:"to_account_id"`
+	Type        string         `db:"type"`
+	Amount      int64          `db:"amount"` // копейки
+	Currency    string         `db:"currency"`
+	CategoryID  uuid.NullUUID  `db:"category_id"`
+	MCC         sql.NullInt32  `db:"mcc"`
+	Description sql.NullString `db:"description"`
+
+	Frequency  string        `db:"frequency"`
+	Interval   int32         `db:"interval"`
+	DayOfMonth sql.NullInt32 `db:"day_of_month"`
+	StartDate  time.Time     `db:"start_date"`
+	EndDate    sql.NullTime  `db:"end_date"`
+	Count      sql.NullInt32 `db:"count"`
+
+	NextOccurrence int32          `db:"next_occurrence"`
+	NextRunAt      sql.NullTime   `db:"next_run_at"`
+	Paused         bool           `db:"paused"`
+	LastError      sql.NullString `db:"last_error"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (r *Recurring) ToProto() *masterpb.RecurringTransaction {
+	pbRecurring := &masterpb.RecurringTransaction{
+		RecurringId: r.ID.String(),
+		UserId:      r.UserID.String(),
+		AccountId:   r.AccountID.String(),
+		Type:        wallet.TransactionDbTypeToPbType(r.Type),
+		Amount: &common.Money{
+			Amount:   r.Amount,
+			Currency: r.Currency,
+		},
+		Description: r.Description.String,
+		Frequency:   FrequencyDbTypeToPbType(r.Frequency),
+		Interval:    r.Interval,
+		DayOfMonth:  r.DayOfMonth.Int32,
+		StartDate:   timestamppb.New(r.StartDate),
+		Count:       r.Count.Int32,
+		Paused:      r.Paused,
+		LastError:   r.LastError.String,
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+	}
+
+	if r.ToAccountID.Valid {
+		pbRecurring.ToAccountId = r.ToAccountID.UUID.String()
+	}
+	if r.CategoryID.Valid {
+		pbRecurring.CategoryId = r.CategoryID.UUID.String()
+	} else if r.MCC.Valid {
+		pbRecurring.CategoryId = strconv.Itoa(int(r.MCC.Int32))
+	}
+	if r.EndDate.Valid {
+		pbRecurring.EndDate = timestamppb.New(r.EndDate.Time)
+	}
+	if r.NextRunAt.Valid {
+		pbRecurring.NextRunAt = timestamppb.New(r.NextRunAt.Time)
+	}
+
+	return pbRecurring
+}
+
+func FrequencyPbTypeToDbType(pbFrequency masterpb.RecurrenceFrequency) string {
+	switch pbFrequency {
+	case masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY:
+		return "DAILY"
+	case masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY:
+		return "WEEKLY"
+	case masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY:
+		return "MONTHLY"
+	case masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY:
+		return "YEARLY"
+	default:
+		return ""
+	}
+}
+
+func FrequencyDbTypeToPbType(dbFrequency string) masterpb.RecurrenceFrequency {
+	switch dbFrequency {
+	case "DAILY":
+		return masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY
+	case "WEEKLY":
+		return masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY
+	case "MONTHLY":
+		return masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY
+	case "YEARLY":
+		return masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY
+	default:
+		return masterpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED
+	}
+}
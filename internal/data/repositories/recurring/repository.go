@@ -0,0 +1,334 @@
+package recurring
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrRecurringNotFound = errors.New("recurring transaction not found")
+)
+
+type RecurringRepository interface {
+	// WithinTx runs fn in a single database transaction carried by the
+	// context passed to fn.
+	WithinTx(
+		ctx context.Context,
+		fn func(ctx context.Context) error,
+	) error
+
+	GetRecurringByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]Recurring, error)
+
+	// GetRecurringForUpdate locks the template until the surrounding
+	// transaction ends.
+	GetRecurringForUpdate(
+		ctx context.Context,
+		userID uuid.UUID,
+		recurringID uuid.UUID,
+	) (*Recurring, error)
+
+	// ClaimDue locks a template whose next occurrence is due at now and
+	// skips templates locked by others. It returns ErrRecurringNotFound when
+	// nothing is due.
+	ClaimDue(
+		ctx context.Context,
+		now time.Time,
+	) (*Recurring, error)
+
+	CreateRecurring(
+		ctx context.Context,
+		recurring *Recurring,
+	) (*Recurring, error)
+
+	// UpdateSchedule stores the schedule position, pause state and last
+	// error of the template.
+	UpdateSchedule(
+		ctx context.Context,
+		recurring *Recurring,
+	) (*Recurring, error)
+
+	DeleteRecurring(
+		ctx context.Context,
+		userID uuid.UUID,
+		recurringID uuid.UUID,
+	) error
+}
+
+const recurringColumns = `
+	id,
+	user_id,
+	account_id,
+	to_account_id,
+	type,
+	amount,
+	currency,
+	category_id,
+	mcc,
+	description,
+	frequency,
+	interval,
+	day_of_month,
+	start_date,
+	end_date,
+	count,
+	next_occurrence,
+	next_run_at,
+	paused,
+	last_error,
+	created_at
+`
+
+type recurringRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) RecurringRepository {
+	return &recurringRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *recurringRepositoryImpl) WithinTx(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return repo.db.WithinTx(ctx, fn)
+}
+
+func (repo *recurringRepositoryImpl) GetRecurringByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]Recurring, error) {
+	query := `
+		SELECT ` + recurringColumns + `
+		FROM recurring_transactions
+
+		WHERE 1=1
+			AND user_id = $1
+
+		ORDER BY created_at
+	`
+
+	var recurring []Recurring
+	err := repo.db.Querier(ctx).SelectContext(ctx, &recurring, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get recurring transactions for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return recurring, nil
+}
+
+func (repo *recurringRepositoryImpl) GetRecurringForUpdate(
+	ctx context.Context,
+	userID uuid.UUID,
+	recurringID uuid.UUID,
+) (*Recurring, error) {
+	query := `
+		SELECT ` + recurringColumns + `
+		FROM recurring_transactions
+
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+
+		FOR UPDATE
+	`
+
+	var recurring Recurring
+	err := repo.db.Querier(ctx).GetContext(ctx, &recurring, query, userID, recurringID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrRecurringNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to get recurring transaction %s: %w",
+			recurringID.String(),
+			err,
+		)
+	}
+
+	return &recurring, nil
+}
+
+func (repo *recurringRepositoryImpl) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+) (*Recurring, error) {
+	query := `
+		SELECT ` + recurringColumns + `
+		FROM recurring_transactions
+
+		WHERE 1=1
+			AND NOT paused
+			AND next_run_at <= $1
+
+		ORDER BY next_run_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	var recurring Recurring
+	err := repo.db.Querier(ctx).GetContext(ctx, &recurring, query, now)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrRecurringNotFound
+		}
+		return nil, fmt.Errorf("failed to claim due recurring transaction: %w", err)
+	}
+
+	return &recurring, nil
+}
+
+func (repo *recurringRepositoryImpl) CreateRecurring(
+	ctx context.Context,
+	recurring *Recurring,
+) (*Recurring, error) {
+	query := `
+		INSERT INTO recurring_transactions (
+			id,
+			user_id,
+			account_id,
+			to_account_id,
+			type,
+			amount,
+			currency,
+			category_id,
+			mcc,
+			description,
+			frequency,
+			interval,
+			day_of_month,
+			start_date,
+			end_date,
+			count,
+			next_occurrence,
+			next_run_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		RETURNING ` + recurringColumns
+
+	var created Recurring
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&created,
+		query,
+		uuid.New(),
+		recurring.UserID,
+		recurring.AccountID,
+		recurring.ToAccountID,
+		recurring.Type,
+		recurring.Amount,
+		recurring.Currency,
+		recurring.CategoryID,
+		recurring.MCC,
+		recurring.Description,
+		recurring.Frequency,
+		recurring.Interval,
+		recurring.DayOfMonth,
+		recurring.StartDate,
+		recurring.EndDate,
+		recurring.Count,
+		recurring.NextOccurrence,
+		recurring.NextRunAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create recurring transaction for uid %s: %w",
+			recurring.UserID.String(),
+			err,
+		)
+	}
+
+	return &created, nil
+}
+
+func (repo *recurringRepositoryImpl) UpdateSchedule(
+	ctx context.Context,
+	recurring *Recurring,
+) (*Recurring, error) {
+	query := `
+		UPDATE recurring_transactions
+		SET
+			next_occurrence = $2,
+			next_run_at = $3,
+			paused = $4,
+			last_error = $5
+		WHERE 1=1
+			AND id = $1
+		RETURNING ` + recurringColumns
+
+	var updated Recurring
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&updated,
+		query,
+		recurring.ID,
+		recurring.NextOccurrence,
+		recurring.NextRunAt,
+		recurring.Paused,
+		recurring.LastError,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrRecurringNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to update recurring transaction %s: %w",
+			recurring.ID.String(),
+			err,
+		)
+	}
+
+	return &updated, nil
+}
+
+func (repo *recurringRepositoryImpl) DeleteRecurring(
+	ctx context.Context,
+	userID uuid.UUID,
+	recurringID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM recurring_transactions
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, recurringID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete recurring transaction %s: %w",
+			recurringID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete recurring transaction %s: %w",
+			recurringID.String(),
+			ErrRecurringNotFound,
+		)
+	}
+
+	return nil
+}
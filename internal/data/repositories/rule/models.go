@@ -0,0 +1,65 @@
+package rule
+
+import (
+	"database/sql"
+	"time"
+
+	masterpb "backend-master/internal/api-gen/proto/master"
+
+	"github.com/google/uuid"
+)
+
+// Rule categorizes, renames and tags transactions. Every set condition must
+// match; unset conditions match anything.
+type Rule struct {
+	ID       uuid.UUID `db:"id"`
+	UserID   uuid.UUID `db:"user_id"`
+	Position int32     `db:"position"`
+	Name     string    `db:"name"`
+	Enabled  bool      `db:"enabled"`
+
+	DescriptionContains sql.NullString `db:"description_contains"`
+	DescriptionRegex    sql.NullString `db:"description_regex"`
+	MinAmount           sql.NullInt64  `db:"min_amount"`
+	MaxAmount           sql.NullInt64  `db:"max_amount"`
+	AccountID           uuid.NullUUID  `db:"account_id"`
+	MCC                 sql.NullInt32  `db:"mcc"`
+
+	SetCategoryID  uuid.NullUUID  `db:"set_category_id"`
+	SetDescription sql.NullString `db:"set_description"`
+	SetTag         sql.NullString `db:"set_tag"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (r *Rule) ToProto() *masterpb.TransactionRule {
+	pbRule := &masterpb.TransactionRule{
+		RuleId:              r.ID.String(),
+		UserId:              r.UserID.String(),
+		Name:                r.Name,
+		Position:            r.Position,
+		Enabled:             &r.Enabled,
+		DescriptionContains: r.DescriptionContains.String,
+		DescriptionRegex:    r.DescriptionRegex.String,
+		SetDescription:      r.SetDescription.String,
+		SetTag:              r.SetTag.String,
+	}
+
+	if r.MinAmount.Valid {
+		pbRule.MinAmount = &r.MinAmount.Int64
+	}
+	if r.MaxAmount.Valid {
+		pbRule.MaxAmount = &r.MaxAmount.Int64
+	}
+	if r.AccountID.Valid {
+		pbRule.AccountId = r.AccountID.UUID.String()
+	}
+	if r.MCC.Valid {
+		pbRule.Mcc = &r.MCC.Int32
+	}
+	if r.SetCategoryID.Valid {
+		pbRule.SetCategoryId = r.SetCategoryID.UUID.String()
+	}
+
+	return pbRule
+}
@@ -0,0 +1,289 @@
+package rule
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrRuleNotFound = errors.New("rule not found")
+)
+
+type RuleRepository interface {
+	// GetRules returns the user's rules in evaluation order.
+	GetRules(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]Rule, error)
+
+	// CreateRule appends the rule after the user's existing rules.
+	CreateRule(
+		ctx context.Context,
+		rule *Rule,
+	) (*Rule, error)
+
+	UpdateRule(
+		ctx context.Context,
+		rule *Rule,
+	) (*Rule, error)
+
+	DeleteRule(
+		ctx context.Context,
+		userID uuid.UUID,
+		ruleID uuid.UUID,
+	) error
+
+	// ReorderRules sets rule positions to their order in ruleIDs.
+	ReorderRules(
+		ctx context.Context,
+		userID uuid.UUID,
+		ruleIDs []uuid.UUID,
+	) error
+}
+
+const ruleColumns = `
+	id,
+	user_id,
+	position,
+	name,
+	enabled,
+	description_contains,
+	description_regex,
+	min_amount,
+	max_amount,
+	account_id,
+	mcc,
+	set_category_id,
+	set_description,
+	set_tag,
+	created_at
+`
+
+type ruleRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) RuleRepository {
+	return &ruleRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *ruleRepositoryImpl) GetRules(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]Rule, error) {
+	query := `
+		SELECT ` + ruleColumns + `
+		FROM transaction_rules
+
+		WHERE 1=1
+			AND user_id = $1
+
+		ORDER BY position, created_at
+	`
+
+	var rules []Rule
+	err := repo.db.Querier(ctx).SelectContext(ctx, &rules, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get rules for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return rules, nil
+}
+
+func (repo *ruleRepositoryImpl) CreateRule(
+	ctx context.Context,
+	rule *Rule,
+) (*Rule, error) {
+	query := `
+		INSERT INTO transaction_rules (
+			id,
+			user_id,
+			position,
+			name,
+			enabled,
+			description_contains,
+			description_regex,
+			min_amount,
+			max_amount,
+			account_id,
+			mcc,
+			set_category_id,
+			set_description,
+			set_tag,
+			created_at
+		)
+		VALUES (
+			$1,
+			$2,
+			COALESCE((SELECT MAX(position) FROM transaction_rules WHERE user_id = $2), 0) + 1,
+			$3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		)
+		RETURNING ` + ruleColumns
+
+	var created Rule
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&created,
+		query,
+		uuid.New(),
+		rule.UserID,
+		rule.Name,
+		rule.Enabled,
+		rule.DescriptionContains,
+		rule.DescriptionRegex,
+		rule.MinAmount,
+		rule.MaxAmount,
+		rule.AccountID,
+		rule.MCC,
+		rule.SetCategoryID,
+		rule.SetDescription,
+		rule.SetTag,
+		rule.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create rule for uid %s: %w",
+			rule.UserID.String(),
+			err,
+		)
+	}
+
+	return &created, nil
+}
+
+func (repo *ruleRepositoryImpl) UpdateRule(
+	ctx context.Context,
+	rule *Rule,
+) (*Rule, error) {
+	query := `
+		UPDATE transaction_rules
+		SET
+			name = $3,
+			enabled = $4,
+			description_contains = $5,
+			description_regex = $6,
+			min_amount = $7,
+			max_amount = $8,
+			account_id = $9,
+			mcc = $10,
+			set_category_id = $11,
+			set_description = $12,
+			set_tag = $13
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+		RETURNING ` + ruleColumns
+
+	var updated Rule
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		&updated,
+		query,
+		rule.UserID,
+		rule.ID,
+		rule.Name,
+		rule.Enabled,
+		rule.DescriptionContains,
+		rule.DescriptionRegex,
+		rule.MinAmount,
+		rule.MaxAmount,
+		rule.AccountID,
+		rule.MCC,
+		rule.SetCategoryID,
+		rule.SetDescription,
+		rule.SetTag,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrRuleNotFound
+		}
+		return nil, fmt.Errorf(
+			"failed to update rule %s: %w",
+			rule.ID.String(),
+			err,
+		)
+	}
+
+	return &updated, nil
+}
+
+func (repo *ruleRepositoryImpl) DeleteRule(
+	ctx context.Context,
+	userID uuid.UUID,
+	ruleID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM transaction_rules
+		WHERE 1=1
+			AND user_id = $1
+			AND id = $2
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, ruleID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete rule %s: %w",
+			ruleID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete rule %s: %w",
+			ruleID.String(),
+			ErrRuleNotFound,
+		)
+	}
+
+	return nil
+}
+
+func (repo *ruleRepositoryImpl) ReorderRules(
+	ctx context.Context,
+	userID uuid.UUID,
+	ruleIDs []uuid.UUID,
+) error {
+	query := `
+		UPDATE transaction_rules r
+		SET position = v.position
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS v(id, position)
+
+		WHERE 1=1
+			AND r.id = v.id
+			AND r.user_id = $1
+	`
+
+	ids := make([]string, 0, len(ruleIDs))
+	for _, id := range ruleIDs {
+		ids = append(ids, id.String())
+	}
+
+	_, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, ids)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to reorder rules for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return nil
+}
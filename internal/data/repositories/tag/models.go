@@ -0,0 +1,55 @@
+package tag
+
+import (
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Tag struct {
+	ID         uuid.UUID `db:"id"`
+	UserID     uuid.UUID `db:"user_id"`
+	Name       string    `db:"name"`
+	UsageCount int64     `db:"usage_count"` // number of tagged transactions, read only
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func (t *Tag) ToProto() *masterpb.Tag {
+	return &masterpb.Tag{
+		TagId:      t.ID.String(),
+		UserId:     t.UserID.String(),
+		Name:       t.Name,
+		UsageCount: t.UsageCount,
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+	}
+}
+
+// Summary totals the income and expenses tagged with a tag in one currency.
+type Summary struct {
+	TagID            uuid.UUID `db:"tag_id"`
+	Name             string    `db:"name"`
+	Currency         string    `db:"currency"`
+	Income           int64     `db:"income"`  // копейки
+	Expense          int64     `db:"expense"` // копейки
+	TransactionCount int64     `db:"transaction_count"`
+}
+
+func (s *Summary) ToProto() *masterpb.TagSummary {
+	return &masterpb.TagSummary{
+		TagId: s.TagID.String(),
+		Name:  s.Name,
+		Income: &common.Money{
+			Amount:   s.Income,
+			Currency: s.Currency,
+		},
+		Expense: &common.Money{
+			Amount:   s.Expense,
+			Currency: s.Currency,
+		},
+		TransactionCount: s.TransactionCount,
+	}
+}
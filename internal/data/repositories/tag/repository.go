@@ -0,0 +1,322 @@
+package tag
+
+import (
+	"backend-master/internal/data/database"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrTransactionNotFound = errors.New("transaction not found")
+)
+
+type TagRepository interface {
+	// GetTags returns the user's tags by name with the number of
+	// transactions tagged with each.
+	GetTags(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]Tag, error)
+
+	// AddTransactionTags tags one of the user's transactions, creating the
+	// tags the user does not have yet, and returns all tags of the
+	// transaction.
+	AddTransactionTags(
+		ctx context.Context,
+		userID uuid.UUID,
+		transactionID uuid.UUID,
+		names []string,
+	) ([]string, error)
+
+	// RemoveTransactionTags removes tags from one of the user's transactions
+	// and returns the remaining ones. The tags themselves are kept.
+	RemoveTransactionTags(
+		ctx context.Context,
+		userID uuid.UUID,
+		transactionID uuid.UUID,
+		names []string,
+	) ([]string, error)
+
+	// GetSummary totals the user's tagged income and expenses made in
+	// [start, end) per tag and currency. Zero times leave the range open.
+	GetSummary(
+		ctx context.Context,
+		userID uuid.UUID,
+		start time.Time,
+		end time.Time,
+	) ([]Summary, error)
+}
+
+type tagRepositoryImpl struct {
+	db     database.DBManager
+	logger *zap.Logger
+}
+
+func NewRepository(
+	db database.DBManager,
+	logger *zap.Logger,
+) TagRepository {
+	return &tagRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *tagRepositoryImpl) GetTags(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]Tag, error) {
+	query := `
+		SELECT
+			g.id,
+			g.user_id,
+			g.name,
+			COUNT(tt.transaction_id) AS usage_count,
+			g.created_at
+		FROM tags g
+		LEFT JOIN transaction_tags tt ON tt.tag_id = g.id
+
+		WHERE 1=1
+			AND g.user_id = $1
+
+		GROUP BY g.id
+		ORDER BY lower(g.name)
+	`
+
+	var tags []Tag
+	err := repo.db.Querier(ctx).SelectContext(ctx, &tags, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get tags for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return tags, nil
+}
+
+func (repo *tagRepositoryImpl) AddTransactionTags(
+	ctx context.Context,
+	userID uuid.UUID,
+	transactionID uuid.UUID,
+	names []string,
+) ([]string, error) {
+	createQuery := `
+		INSERT INTO tags (
+			id,
+			user_id,
+			name
+		)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, lower(name)) DO NOTHING
+	`
+
+	linkQuery := `
+		INSERT INTO transaction_tags (
+			transaction_id,
+			tag_id
+		)
+		SELECT $2::uuid, g.id
+		FROM tags g
+
+		WHERE 1=1
+			AND g.user_id = $1
+			AND lower(g.name) IN (SELECT lower(n) FROM unnest($3::text[]) n)
+
+		ON CONFLICT DO NOTHING
+	`
+
+	var tags []string
+	err := repo.db.WithinTx(ctx, func(ctx context.Context) error {
+		if err := repo.checkTransaction(ctx, userID, transactionID); err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			_, err := repo.db.Querier(ctx).ExecContext(ctx, createQuery, uuid.New(), userID, name)
+			if err != nil {
+				return fmt.Errorf("failed to create tag %q: %w", name, err)
+			}
+		}
+
+		_, err := repo.db.Querier(ctx).ExecContext(ctx, linkQuery, userID, transactionID, names)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to tag transaction %s: %w",
+				transactionID.String(),
+				err,
+			)
+		}
+
+		tags, err = repo.transactionTags(ctx, transactionID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (repo *tagRepositoryImpl) RemoveTransactionTags(
+	ctx context.Context,
+	userID uuid.UUID,
+	transactionID uuid.UUID,
+	names []string,
+) ([]string, error) {
+	query := `
+		DELETE FROM transaction_tags tt
+		USING tags g
+
+		WHERE 1=1
+			AND g.id = tt.tag_id
+			AND g.user_id = $1
+			AND tt.transaction_id = $2
+			AND lower(g.name) IN (SELECT lower(n) FROM unnest($3::text[]) n)
+	`
+
+	var tags []string
+	err := repo.db.WithinTx(ctx, func(ctx context.Context) error {
+		if err := repo.checkTransaction(ctx, userID, transactionID); err != nil {
+			return err
+		}
+
+		_, err := repo.db.Querier(ctx).ExecContext(ctx, query, userID, transactionID, names)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to untag transaction %s: %w",
+				transactionID.String(),
+				err,
+			)
+		}
+
+		tags, err = repo.transactionTags(ctx, transactionID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (repo *tagRepositoryImpl) GetSummary(
+	ctx context.Context,
+	userID uuid.UUID,
+	start time.Time,
+	end time.Time,
+) ([]Summary, error) {
+	query := `
+		SELECT
+			g.id AS tag_id,
+			g.name,
+			t.currency,
+			COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'INCOME'), 0) AS income,
+			COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'EXPENSE'), 0) AS expense,
+			COUNT(*) AS transaction_count
+		FROM tags g
+		JOIN transaction_tags tt ON tt.tag_id = g.id
+		JOIN transactions t ON t.id = tt.transaction_id
+
+		WHERE 1=1
+			AND g.user_id = $1
+			AND t.type IN ('INCOME', 'EXPENSE')
+			AND ($2::timestamptz IS NULL OR t.created_at >= $2)
+			AND ($3::timestamptz IS NULL OR t.created_at < $3)
+
+		GROUP BY g.id, t.currency
+		ORDER BY lower(g.name), t.currency
+	`
+
+	var summaries []Summary
+	err := repo.db.Querier(ctx).SelectContext(
+		ctx,
+		&summaries,
+		query,
+		userID,
+		sql.NullTime{Time: start, Valid: !start.IsZero()},
+		sql.NullTime{Time: end, Valid: !end.IsZero()},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to summarize tags for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return summaries, nil
+}
+
+// checkTransaction makes sure the transaction belongs to the user.
+func (repo *tagRepositoryImpl) checkTransaction(
+	ctx context.Context,
+	userID uuid.UUID,
+	transactionID uuid.UUID,
+) error {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM transactions t
+			JOIN accounts a ON a.id = t.account_id
+
+			WHERE 1=1
+				AND t.id = $2
+				AND a.user_id = $1
+		)
+	`
+
+	var exists bool
+	err := repo.db.Querier(ctx).GetContext(ctx, &exists, query, userID, transactionID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get transaction %s: %w",
+			transactionID.String(),
+			err,
+		)
+	}
+	if !exists {
+		return fmt.Errorf(
+			"failed to get transaction %s: %w",
+			transactionID.String(),
+			ErrTransactionNotFound,
+		)
+	}
+
+	return nil
+}
+
+func (repo *tagRepositoryImpl) transactionTags(
+	ctx context.Context,
+	transactionID uuid.UUID,
+) ([]string, error) {
+	query := `
+		SELECT g.name
+		FROM transaction_tags tt
+		JOIN tags g ON g.id = tt.tag_id
+
+		WHERE 1=1
+			AND tt.transaction_id = $1
+
+		ORDER BY lower(g.name)
+	`
+
+	var tags []string
+	err := repo.db.Querier(ctx).SelectContext(ctx, &tags, query, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get tags of transaction %s: %w",
+			transactionID.String(),
+			err,
+		)
+	}
+
+	return tags, nil
+}
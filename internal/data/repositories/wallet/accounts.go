@@ -0,0 +1,300 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (repo *walletRepositoryImpl) CreateAccount(
+	ctx context.Context,
+	acc *Account,
+) (*Account, error) {
+	query := `
+		INSERT INTO accounts (
+			id,
+			user_id,
+			name,
+			type,
+			balance,
+			currency,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, user_id, name, type, balance, currency, created_at, archived_at
+	`
+
+	acc.ID = uuid.New()
+
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		acc,
+		query,
+		acc.ID,
+		acc.UserID,
+		acc.Name,
+		acc.Type,
+		acc.Balance,
+		acc.Currency,
+		acc.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create account for uid %s: %w",
+			acc.UserID.String(),
+			err,
+		)
+	}
+
+	return acc, nil
+}
+
+func (repo *walletRepositoryImpl) UpdateAccountName(
+	ctx context.Context,
+	accountID uuid.UUID,
+	name string,
+) (*Account, error) {
+	query := `
+		UPDATE accounts
+		SET name = $2
+		WHERE id = $1
+		RETURNING id, user_id, name, type, balance, currency, created_at, archived_at
+	`
+
+	var acc Account
+	err := repo.db.Querier(ctx).GetContext(ctx, &acc, query, accountID, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(
+			"failed to rename account %s: %w",
+			accountID.String(),
+			ErrAccountNotFound,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to rename account %s: %w",
+			accountID.String(),
+			err,
+		)
+	}
+
+	return &acc, nil
+}
+
+func (repo *walletRepositoryImpl) SetAccountArchived(
+	ctx context.Context,
+	accountID uuid.UUID,
+	archived bool,
+) (*Account, error) {
+	query := `
+		UPDATE accounts
+		SET archived_at = CASE
+			WHEN NOT $2 THEN NULL
+			ELSE COALESCE(archived_at, NOW())
+		END
+		WHERE id = $1
+		RETURNING id, user_id, name, type, balance, currency, created_at, archived_at
+	`
+
+	var acc Account
+	err := repo.db.Querier(ctx).GetContext(ctx, &acc, query, accountID, archived)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(
+			"failed to archive account %s: %w",
+			accountID.String(),
+			ErrAccountNotFound,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to archive account %s: %w",
+			accountID.String(),
+			err,
+		)
+	}
+
+	return &acc, nil
+}
+
+func (repo *walletRepositoryImpl) DeleteAccount(
+	ctx context.Context,
+	accountID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM accounts
+		WHERE id = $1
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, accountID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete account %s: %w",
+			accountID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete account %s: %w",
+			accountID.String(),
+			ErrAccountNotFound,
+		)
+	}
+
+	return nil
+}
+
+func (repo *walletRepositoryImpl) CountAccountTransactions(
+	ctx context.Context,
+	accountID uuid.UUID,
+) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM transactions
+
+		WHERE 1=1
+			AND account_id = $1
+	`
+
+	var count int64
+	err := repo.db.Querier(ctx).GetContext(ctx, &count, query, accountID)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to count transactions for aid %s: %w",
+			accountID.String(),
+			err,
+		)
+	}
+
+	return count, nil
+}
+
+func (repo *walletRepositoryImpl) CountTransfersBetween(
+	ctx context.Context,
+	accountID uuid.UUID,
+	otherAccountID uuid.UUID,
+) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM transactions
+
+		WHERE 1=1
+			AND type = 'TRANSFER'
+			AND account_id = $1
+			AND (from_account_id = $2 OR to_account_id = $2)
+	`
+
+	var count int64
+	err := repo.db.Querier(ctx).GetContext(ctx, &count, query, accountID, otherAccountID)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to count transfers between aid %s and aid %s: %w",
+			accountID.String(),
+			otherAccountID.String(),
+			err,
+		)
+	}
+
+	return count, nil
+}
+
+func (repo *walletRepositoryImpl) SumTransactionsEffect(
+	ctx context.Context,
+	accountID uuid.UUID,
+) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(` + balanceEffect + `), 0)
+		FROM transactions t
+
+		WHERE 1=1
+			AND t.account_id = $1
+	`
+
+	var effect int64
+	err := repo.db.Querier(ctx).GetContext(ctx, &effect, query, accountID)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to sum transactions for aid %s: %w",
+			accountID.String(),
+			err,
+		)
+	}
+
+	return effect, nil
+}
+
+func (repo *walletRepositoryImpl) ReassignTransactions(
+	ctx context.Context,
+	fromAccountID uuid.UUID,
+	toAccountID uuid.UUID,
+) error {
+	queries := []string{
+		`
+		UPDATE transactions
+		SET account_id = $2
+		WHERE account_id = $1
+		`,
+		`
+		UPDATE transactions
+		SET from_account_id = $2
+		WHERE from_account_id = $1
+		`,
+		`
+		UPDATE transactions
+		SET to_account_id = $2
+		WHERE to_account_id = $1
+		`,
+	}
+
+	for _, query := range queries {
+		_, err := repo.db.Querier(ctx).ExecContext(ctx, query, fromAccountID, toAccountID)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to reassign transactions from aid %s to aid %s: %w",
+				fromAccountID.String(),
+				toAccountID.String(),
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (repo *walletRepositoryImpl) GetBalanceChanges(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+) ([]BalanceChange, error) {
+	query := `
+		SELECT
+			t.account_id,
+			date_trunc('day', t.created_at, 'UTC') AS day,
+			SUM(` + balanceEffect + `) AS amount
+		FROM transactions t
+		JOIN accounts a ON a.id = t.account_id
+
+		WHERE 1=1
+			AND a.user_id = $1
+			AND t.created_at >= $2
+
+		GROUP BY t.account_id, day
+		ORDER BY day, t.account_id
+	`
+
+	var changes []BalanceChange
+	err := repo.db.Querier(ctx).SelectContext(ctx, &changes, query, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get balance changes for uid %s: %w",
+			userID.String(),
+			err,
+		)
+	}
+
+	return changes, nil
+}
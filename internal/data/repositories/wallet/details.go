@@ -0,0 +1,95 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func (repo *walletRepositoryImpl) GetTransactionTags(
+	ctx context.Context,
+	transactionIDs []uuid.UUID,
+) (map[uuid.UUID][]string, error) {
+	if len(transactionIDs) == 0 {
+		return map[uuid.UUID][]string{}, nil
+	}
+
+	ids := make([]string, 0, len(transactionIDs))
+	for _, id := range transactionIDs {
+		ids = append(ids, id.String())
+	}
+
+	query := `
+		SELECT
+			tt.transaction_id,
+			g.name
+		FROM transaction_tags tt
+		JOIN tags g ON g.id = tt.tag_id
+
+		WHERE 1=1
+			AND tt.transaction_id = ANY($1::uuid[])
+
+		ORDER BY tt.transaction_id, lower(g.name)
+	`
+
+	var rows []struct {
+		TransactionID uuid.UUID `db:"transaction_id"`
+		Name          string    `db:"name"`
+	}
+	err := repo.db.Querier(ctx).SelectContext(ctx, &rows, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction tags: %w", err)
+	}
+
+	byTransaction := make(map[uuid.UUID][]string)
+	for _, row := range rows {
+		byTransaction[row.TransactionID] = append(byTransaction[row.TransactionID], row.Name)
+	}
+
+	return byTransaction, nil
+}
+
+func (repo *walletRepositoryImpl) GetAttachments(
+	ctx context.Context,
+	transactionIDs []uuid.UUID,
+) (map[uuid.UUID][]Attachment, error) {
+	if len(transactionIDs) == 0 {
+		return map[uuid.UUID][]Attachment{}, nil
+	}
+
+	ids := make([]string, 0, len(transactionIDs))
+	for _, id := range transactionIDs {
+		ids = append(ids, id.String())
+	}
+
+	query := `
+		SELECT
+			id,
+			transaction_id,
+			file_name,
+			content_type,
+			size,
+			storage_key,
+			created_at
+		FROM attachments
+
+		WHERE 1=1
+			AND transaction_id = ANY($1::uuid[])
+
+		ORDER BY transaction_id, created_at, id
+	`
+
+	var attachments []Attachment
+	err := repo.db.Querier(ctx).SelectContext(ctx, &attachments, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction attachments: %w", err)
+	}
+
+	byTransaction := make(map[uuid.UUID][]Attachment)
+	for _, attachment := range attachments {
+		byTransaction[attachment.TransactionID] = append(byTransaction[attachment.TransactionID], attachment)
+	}
+
+	return byTransaction, nil
+}
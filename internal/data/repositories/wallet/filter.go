@@ -0,0 +1,136 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"backend-master/internal/data/database"
+
+	"github.com/google/uuid"
+)
+
+// TransactionFilter narrows GetTransactions down. Zero values mean "no
+// restriction" for every field except UserID and Limit.
+type TransactionFilter struct {
+	UserID      uuid.UUID
+	StartDate   time.Time
+	EndDate     time.Time
+	AccountIDs  []uuid.UUID
+	Type        string
+	MCCs        []int32
+	CategoryIDs []uuid.UUID // matched together with their subcategories
+	Tags        []string    // tag names, any of them matches
+	MinAmount   *int64
+	MaxAmount   *int64
+	Description string
+	After       *database.Cursor
+	Limit       int
+}
+
+// where renders the filter as SQL conditions over transactions t joined with
+// accounts a, together with their positional arguments.
+func (f *TransactionFilter) where() (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conds = append(conds, "a.user_id = "+arg(f.UserID))
+
+	if !f.StartDate.IsZero() {
+		conds = append(conds, "t.created_at >= "+arg(f.StartDate))
+	}
+	if !f.EndDate.IsZero() {
+		conds = append(conds, "t.created_at < "+arg(f.EndDate))
+	}
+	if len(f.AccountIDs) > 0 {
+		ids := make([]string, 0, len(f.AccountIDs))
+		for _, id := range f.AccountIDs {
+			ids = append(ids, id.String())
+		}
+		conds = append(conds, "t.account_id = ANY("+arg(ids)+"::uuid[])")
+	}
+	if f.Type != "" {
+		conds = append(conds, "t.type = "+arg(f.Type))
+	}
+	if len(f.MCCs) > 0 || len(f.CategoryIDs) > 0 {
+		// a split transaction also matches the categories of its splits
+		var mccs, categoryIDs string
+		if len(f.MCCs) > 0 {
+			mccs = arg(f.MCCs)
+		}
+		if len(f.CategoryIDs) > 0 {
+			ids := make([]string, 0, len(f.CategoryIDs))
+			for _, id := range f.CategoryIDs {
+				ids = append(ids, id.String())
+			}
+			categoryIDs = arg(ids)
+		}
+
+		conds = append(conds, fmt.Sprintf(
+			`(%s OR EXISTS (
+				SELECT 1 FROM transaction_splits s
+				WHERE s.transaction_id = t.id AND %s
+			))`,
+			categoryCond("t", mccs, categoryIDs),
+			categoryCond("s", mccs, categoryIDs),
+		))
+	}
+	if len(f.Tags) > 0 {
+		conds = append(conds, `EXISTS (
+				SELECT 1
+				FROM transaction_tags tt
+				JOIN tags g ON g.id = tt.tag_id
+				WHERE tt.transaction_id = t.id
+					AND lower(g.name) IN (SELECT lower(n) FROM unnest(`+arg(f.Tags)+`::text[]) n)
+			)`)
+	}
+	if f.MinAmount != nil {
+		conds = append(conds, "t.amount >= "+arg(*f.MinAmount))
+	}
+	if f.MaxAmount != nil {
+		conds = append(conds, "t.amount <= "+arg(*f.MaxAmount))
+	}
+	if f.Description != "" {
+		conds = append(conds, "t.description ILIKE '%' || "+arg(escapeLike(f.Description))+" || '%'")
+	}
+	if f.After != nil {
+		conds = append(
+			conds,
+			fmt.Sprintf("(t.created_at, t.id) < (%s, %s)", arg(f.After.CreatedAt), arg(f.After.ID)),
+		)
+	}
+
+	return strings.Join(conds, "\n\t\t\tAND "), args
+}
+
+// categoryCond matches the MCC or the category, including its subcategories,
+// of the table aliased as alias against the array parameters mccs and
+// categoryIDs, either of which may be empty.
+func categoryCond(alias string, mccs string, categoryIDs string) string {
+	var conds []string
+	if mccs != "" {
+		conds = append(conds, alias+".mcc = ANY("+mccs+"::int[])")
+	}
+	if categoryIDs != "" {
+		conds = append(conds, alias+`.category_id IN (
+				WITH RECURSIVE sub AS (
+					SELECT id FROM categories WHERE id = ANY(`+categoryIDs+`::uuid[])
+					UNION
+					SELECT c.id FROM categories c JOIN sub ON c.parent_id = sub.id
+				)
+				SELECT id FROM sub
+			)`)
+	}
+	return "(" + strings.Join(conds, " OR ") + ")"
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -3,6 +3,7 @@ package wallet
 import (
 	"database/sql"
 	"fmt"
+	"math/big"
 	"time"
 
 	"backend-master/internal/api-gen/proto/common"
@@ -13,25 +14,67 @@ import (
 )
 
 type Account struct {
-	ID        uuid.UUID `db:"id"`
-	UserID    uuid.UUID `db:"user_id"`
-	Name      string    `db:"name"`
-	Type      string    `db:"type"`
-	Balance   int64     `db:"balance"` // копейки, сущие копейки
-	Currency  string    `db:"currency"`
-	CreatedAt time.Time `db:"created_at"`
+	ID         uuid.UUID    `db:"id"`
+	UserID     uuid.UUID    `db:"user_id"`
+	Name       string       `db:"name"`
+	Type       string       `db:"type"`
+	Balance    int64        `db:"balance"` // копейки, сущие копейки
+	Currency   string       `db:"currency"`
+	CreatedAt  time.Time    `db:"created_at"`
+	ArchivedAt sql.NullTime `db:"archived_at"`
 }
 
+// Transaction is a ledger entry of one account. A transfer is made of two
+// entries linked by TransferID, one in each account, that both carry the
+// source and the target accounts.
 type Transaction struct {
 	ID          uuid.UUID      `db:"id"`
 	AccountID   uuid.UUID      `db:"account_id"`
-	ToAccountID sql.NullString `db:"to_account_id"`
 	Type        string         `db:"type"`
 	Amount      int64          `db:"amount"` // копейки, сущие копейки
 	Currency    string         `db:"currency"`
 	MCC         sql.NullInt32  `db:"mcc"`
+	CategoryID  uuid.NullUUID  `db:"category_id"`
 	Description sql.NullString `db:"description"`
 	CreatedAt   time.Time      `db:"created_at"`
+
+	TransferID    uuid.NullUUID `db:"transfer_id"`
+	FromAccountID uuid.NullUUID `db:"from_account_id"`
+	ToAccountID   uuid.NullUUID `db:"to_account_id"`
+
+	ImportFingerprint sql.NullString `db:"import_fingerprint"`
+	ExternalID        sql.NullString `db:"external_id"`
+	RecurringID       uuid.NullUUID  `db:"recurring_id"`
+	Occurrence        sql.NullInt32  `db:"occurrence"`
+
+	CategoryName sql.NullString `db:"category_name"` // resolved from category_id, read only
+
+	Splits      []Split      `db:"-"` // loaded separately, see GetSplits
+	Tags        []string     `db:"-"` // loaded separately, see GetTransactionTags
+	Attachments []Attachment `db:"-"` // loaded separately, see GetAttachments
+	Counterpart *Transaction `db:"-"` // the other leg of a transfer, loaded separately, see GetTransferLegs
+}
+
+// BalanceChange is the net effect of the transactions made on a day on the
+// balance of an account.
+type BalanceChange struct {
+	AccountID uuid.UUID `db:"account_id"`
+	Day       time.Time `db:"day"`
+	Amount    int64     `db:"amount"` // копейки
+}
+
+// Split is a share of a transaction in a category other than the one of the
+// whole transaction.
+type Split struct {
+	ID            uuid.UUID      `db:"id"`
+	TransactionID uuid.UUID      `db:"transaction_id"`
+	Position      int32          `db:"position"`
+	CategoryID    uuid.NullUUID  `db:"category_id"`
+	MCC           sql.NullInt32  `db:"mcc"`
+	Amount        int64          `db:"amount"` // копейки
+	Note          sql.NullString `db:"note"`
+
+	CategoryName sql.NullString `db:"category_name"` // resolved from category_id, read only
 }
 
 func (acc *Account) ToProto() *pb.Account {
@@ -44,9 +87,10 @@ func (acc *Account) ToProto() *pb.Account {
 		AccountId: acc.ID.String(),
 		UserId:    acc.UserID.String(),
 		Name:      acc.Name,
-		Type:      common.AccountType(common.AccountType_value[acc.Type]),
+		Type:      AccountDbTypeToPbType(acc.Type),
 		Balance:   money,
 		CreatedAt: timestamppb.New(acc.CreatedAt),
+		Archived:  acc.ArchivedAt.Valid,
 	}
 }
 
@@ -79,24 +123,116 @@ func (tx *Transaction) ToProto() *pb.Transaction {
 	}
 
 	pbTx := &pb.Transaction{
+		TransactionId: tx.ID.String(),
 		AccountId:     tx.AccountID.String(),
 		Type:          TransactionDbTypeToPbType(tx.Type),
 		Amount:        money,
-		FromAccountId: tx.AccountID.String(),
-		ToAccountId:   tx.AccountID.String(),
 		Date:          timestamppb.New(tx.CreatedAt),
 	}
 
+	switch tx.Type {
+	case "TRANSFER":
+		if tx.FromAccountID.Valid {
+			pbTx.FromAccountId = tx.FromAccountID.UUID.String()
+		}
+		if tx.ToAccountID.Valid {
+			pbTx.ToAccountId = tx.ToAccountID.UUID.String()
+		}
+		if tx.TransferID.Valid {
+			pbTx.TransferId = tx.TransferID.UUID.String()
+		}
+		if tx.Counterpart != nil {
+			debit, credit := tx, tx.Counterpart
+			if !tx.IsOutgoingTransfer() {
+				debit, credit = credit, debit
+			}
+
+			pbTx.DebitAmount = &common.Money{Amount: debit.Amount, Currency: debit.Currency}
+			pbTx.CreditAmount = &common.Money{Amount: credit.Amount, Currency: credit.Currency}
+			if debit.Amount != 0 {
+				pbTx.ExchangeRate = big.NewRat(credit.Amount, debit.Amount).FloatString(6)
+			}
+		}
+	case "EXPENSE":
+		pbTx.FromAccountId = tx.AccountID.String()
+	default:
+		pbTx.ToAccountId = tx.AccountID.String()
+	}
+
 	if tx.MCC.Valid {
+		pbTx.Mcc = tx.MCC.Int32
 		pbTx.Category = fmt.Sprintf("%d", tx.MCC.Int32)
 	}
+	if tx.CategoryID.Valid {
+		pbTx.CategoryId = tx.CategoryID.UUID.String()
+	}
+	if tx.CategoryName.Valid {
+		pbTx.Category = tx.CategoryName.String
+	}
 	if tx.Description.Valid {
 		pbTx.Description = tx.Description.String
 	}
+	for _, split := range tx.Splits {
+		pbTx.Splits = append(pbTx.Splits, split.ToProto())
+	}
+	pbTx.Tags = tx.Tags
+	for _, attachment := range tx.Attachments {
+		pbTx.Attachments = append(pbTx.Attachments, attachment.ToProto())
+	}
 
 	return pbTx
 }
 
+// IsOutgoingTransfer tells whether the transaction is the leg of a transfer
+// that takes the money out of the source account. Every transfer leg that is
+// not the incoming one is, the same rule balanceEffect applies in SQL.
+func (tx *Transaction) IsOutgoingTransfer() bool {
+	return tx.Type == "TRANSFER" && !(tx.ToAccountID.Valid && tx.ToAccountID.UUID == tx.AccountID)
+}
+
+// Attachment describes a file attached to a transaction.
+type Attachment struct {
+	ID            uuid.UUID `db:"id"`
+	TransactionID uuid.UUID `db:"transaction_id"`
+	FileName      string    `db:"file_name"`
+	ContentType   string    `db:"content_type"`
+	Size          int64     `db:"size"`
+	StorageKey    string    `db:"storage_key"` // not exposed, used to clean up the content
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+func (attachment *Attachment) ToProto() *pb.Attachment {
+	return &pb.Attachment{
+		AttachmentId: attachment.ID.String(),
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		CreatedAt:    timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+func (split *Split) ToProto() *pb.TransactionSplit {
+	pbSplit := &pb.TransactionSplit{
+		Amount: split.Amount,
+	}
+
+	if split.MCC.Valid {
+		pbSplit.Mcc = split.MCC.Int32
+		pbSplit.Category = fmt.Sprintf("%d", split.MCC.Int32)
+	}
+	if split.CategoryID.Valid {
+		pbSplit.CategoryId = split.CategoryID.UUID.String()
+	}
+	if split.CategoryName.Valid {
+		pbSplit.Category = split.CategoryName.String
+	}
+	if split.Note.Valid {
+		pbSplit.Note = split.Note.String
+	}
+
+	return pbSplit
+}
+
 func TransactionPbTypeToDbType(pbTxType common.TransactionType) string {
 	switch pbTxType {
 	case 1:
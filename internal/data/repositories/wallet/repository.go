@@ -3,28 +3,194 @@ package wallet
 import (
 	"backend-master/internal/data/database"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/zap"
 )
 
+var (
+	ErrAccountNotFound     = errors.New("account not found")
+	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionExists   = errors.New("transaction already exists")
+)
+
 type WalletRepository interface {
+	// WithinTx runs fn in a single database transaction carried by the
+	// context passed to fn; repository calls made with that context join it.
+	WithinTx(
+		ctx context.Context,
+		fn func(ctx context.Context, repo WalletRepository) error,
+	) error
+
+	// LockAccounts takes row locks on the given accounts until the end of the
+	// current transaction. Locks are acquired in id order to avoid deadlocks.
+	LockAccounts(
+		ctx context.Context,
+		accountIDs ...uuid.UUID,
+	) ([]Account, error)
+
 	GetAccountsByUserID(
 		ctx context.Context,
 		userID uuid.UUID,
 	) ([]Account, error)
 
+	CreateAccount(
+		ctx context.Context,
+		acc *Account,
+	) (*Account, error)
+
+	UpdateAccountName(
+		ctx context.Context,
+		accountID uuid.UUID,
+		name string,
+	) (*Account, error)
+
+	SetAccountArchived(
+		ctx context.Context,
+		accountID uuid.UUID,
+		archived bool,
+	) (*Account, error)
+
+	DeleteAccount(
+		ctx context.Context,
+		accountID uuid.UUID,
+	) error
+
+	CountAccountTransactions(
+		ctx context.Context,
+		accountID uuid.UUID,
+	) (int64, error)
+
+	CountTransfersBetween(
+		ctx context.Context,
+		accountID uuid.UUID,
+		otherAccountID uuid.UUID,
+	) (int64, error)
+
+	// SumTransactionsEffect returns the net balance change that all
+	// transactions of the account have applied to it.
+	SumTransactionsEffect(
+		ctx context.Context,
+		accountID uuid.UUID,
+	) (int64, error)
+
+	// GetBalanceChanges returns the net effect that the user's transactions
+	// made at or after since had on the balances of their accounts, per
+	// account and UTC day, oldest first.
+	GetBalanceChanges(
+		ctx context.Context,
+		userID uuid.UUID,
+		since time.Time,
+	) ([]BalanceChange, error)
+
+	// ReassignTransactions moves every transaction referencing fromAccountID,
+	// as its account or as a transfer source or target, to toAccountID.
+	ReassignTransactions(
+		ctx context.Context,
+		fromAccountID uuid.UUID,
+		toAccountID uuid.UUID,
+	) error
+
 	GetTransactionsByAccountID(
 		ctx context.Context,
 		accountID uuid.UUID,
 	) ([]Transaction, error)
 
+	// GetTransactions returns a page of the user's transactions matching the
+	// filter, newest first.
+	GetTransactions(
+		ctx context.Context,
+		filter TransactionFilter,
+	) ([]Transaction, error)
+
+	// StreamTransactions calls fn for every transaction of the user matching
+	// the filter, oldest first, reading them from the database one at a time
+	// instead of loading them all. The filter limit is ignored.
+	StreamTransactions(
+		ctx context.Context,
+		filter TransactionFilter,
+		fn func(tx *Transaction) error,
+	) error
+
 	CreateTransaction(
 		ctx context.Context,
 		tx *Transaction,
 	) (*Transaction, error)
 
+	// GetTransactionForUpdate returns the transaction and locks its row until
+	// the end of the current transaction.
+	GetTransactionForUpdate(
+		ctx context.Context,
+		transactionID uuid.UUID,
+	) (*Transaction, error)
+
+	UpdateTransaction(
+		ctx context.Context,
+		tx *Transaction,
+	) (*Transaction, error)
+
+	// GetTransferLegForUpdate returns the other leg of a transfer and locks
+	// its row until the end of the current transaction.
+	GetTransferLegForUpdate(
+		ctx context.Context,
+		tx *Transaction,
+	) (*Transaction, error)
+
+	// GetTransferLegs returns the legs of the transfers keyed by transfer.
+	GetTransferLegs(
+		ctx context.Context,
+		transferIDs []uuid.UUID,
+	) (map[uuid.UUID][]Transaction, error)
+
+	// UpdateTransactionDetails changes the category and description of a
+	// transaction, which never affects balances.
+	UpdateTransactionDetails(
+		ctx context.Context,
+		transactionID uuid.UUID,
+		categoryID uuid.NullUUID,
+		description sql.NullString,
+	) error
+
+	DeleteTransaction(
+		ctx context.Context,
+		transactionID uuid.UUID,
+	) error
+
+	// GetSplits returns the split lines of the transactions in their order,
+	// keyed by transaction. Transactions without splits are left out.
+	GetSplits(
+		ctx context.Context,
+		transactionIDs []uuid.UUID,
+	) (map[uuid.UUID][]Split, error)
+
+	// GetTransactionTags returns the tag names of the transactions, keyed by
+	// transaction. Transactions without tags are left out.
+	GetTransactionTags(
+		ctx context.Context,
+		transactionIDs []uuid.UUID,
+	) (map[uuid.UUID][]string, error)
+
+	// GetAttachments returns the attachments of the transactions, oldest
+	// first, keyed by transaction. Transactions without attachments are left
+	// out.
+	GetAttachments(
+		ctx context.Context,
+		transactionIDs []uuid.UUID,
+	) (map[uuid.UUID][]Attachment, error)
+
+	// ReplaceSplits replaces the split lines of a transaction. Without splits
+	// the transaction is no longer split.
+	ReplaceSplits(
+		ctx context.Context,
+		transactionID uuid.UUID,
+		splits []Split,
+	) ([]Split, error)
+
 	UpdateAccountBalance(
 		ctx context.Context,
 		accountID uuid.UUID,
@@ -32,6 +198,48 @@ type WalletRepository interface {
 	) error
 }
 
+// transactionColumns selects transactions t left joined with their
+// categories c.
+const transactionColumns = `
+	t.id,
+	t.account_id,
+	t.to_account_id,
+	t.type,
+	t.amount,
+	t.currency,
+	t.mcc,
+	t.category_id,
+	t.description,
+	t.created_at,
+	t.import_fingerprint,
+	t.external_id,
+	t.recurring_id,
+	t.occurrence,
+	t.transfer_id,
+	t.from_account_id,
+	c.name AS category_name
+`
+
+// balanceEffect is the signed change a transaction t made to the balance of
+// its account.
+const balanceEffect = `CASE
+	WHEN t.type = 'INCOME' THEN t.amount
+	WHEN t.type = 'TRANSFER' AND t.to_account_id = t.account_id THEN t.amount
+	ELSE -t.amount
+END`
+
+// categoryIDOrMCC picks the explicit category id, or the category mapped to
+// the MCC when there is none.
+const categoryIDOrMCC = `COALESCE(
+	$10::uuid,
+	(
+		SELECT m.category_id
+		FROM mcc_categories m
+		WHERE $7::int BETWEEN m.mcc_from AND m.mcc_to
+		LIMIT 1
+	)
+)`
+
 type walletRepositoryImpl struct {
 	db     database.DBManager
 	logger *zap.Logger
@@ -47,6 +255,56 @@ func NewRepository(
 	}
 }
 
+func (repo *walletRepositoryImpl) WithinTx(
+	ctx context.Context,
+	fn func(ctx context.Context, repo WalletRepository) error,
+) error {
+	return repo.db.WithinTx(ctx, func(ctx context.Context) error {
+		return fn(ctx, repo)
+	})
+}
+
+func (repo *walletRepositoryImpl) LockAccounts(
+	ctx context.Context,
+	accountIDs ...uuid.UUID,
+) ([]Account, error) {
+	query := `
+		SELECT 
+			id, 
+			user_id, 
+			name, 
+			type, 
+			balance, 
+			currency, 
+			created_at,
+			archived_at
+		FROM accounts
+
+		WHERE 1=1
+			AND id = ANY($1::uuid[])
+
+		ORDER BY id
+		FOR UPDATE
+	`
+
+	ids := make([]string, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		ids = append(ids, id.String())
+	}
+
+	var accounts []Account
+	err := repo.db.Querier(ctx).SelectContext(ctx, &accounts, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock accounts %v: %w", ids, err)
+	}
+
+	if len(accounts) != len(uniqueIDs(accountIDs)) {
+		return nil, fmt.Errorf("failed to lock accounts %v: %w", ids, ErrAccountNotFound)
+	}
+
+	return accounts, nil
+}
+
 func (repo *walletRepositoryImpl) GetAccountsByUserID(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -59,7 +317,8 @@ func (repo *walletRepositoryImpl) GetAccountsByUserID(
 			type, 
 			balance, 
 			currency, 
-			created_at
+			created_at,
+			archived_at
 		FROM accounts
 
 		WHERE 1=1
@@ -69,7 +328,7 @@ func (repo *walletRepositoryImpl) GetAccountsByUserID(
 	`
 
 	var accounts []Account
-	err := repo.db.GetDB().SelectContext(ctx, &accounts, query, userID)
+	err := repo.db.Querier(ctx).SelectContext(ctx, &accounts, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"failed to get accounts for uid: %s %w",
@@ -86,27 +345,18 @@ func (repo *walletRepositoryImpl) GetTransactionsByAccountID(
 	accountID uuid.UUID,
 ) ([]Transaction, error) {
 	query := `
-		SELECT 
-			id,
-			account_id,
-			to_account_id,
-			type,
-			amount,
-			currency,
-			mcc,
-			description,
-			created_at
-
-		FROM transactions
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON c.id = t.category_id
 
 		WHERE 1=1
-			AND account_id = $1
+			AND t.account_id = $1
 
-		ORDER BY created_at DESC
+		ORDER BY t.created_at DESC
 	`
 
 	var transactions []Transaction
-	err := repo.db.GetDB().SelectContext(ctx, &transactions, query, accountID)
+	err := repo.db.Querier(ctx).SelectContext(ctx, &transactions, query, accountID)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"failed to get transactions for aid %s: %w",
@@ -118,28 +368,128 @@ func (repo *walletRepositoryImpl) GetTransactionsByAccountID(
 	return transactions, nil
 }
 
+func (repo *walletRepositoryImpl) GetTransactions(
+	ctx context.Context,
+	filter TransactionFilter,
+) ([]Transaction, error) {
+	where, args := filter.where()
+	args = append(args, filter.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT `+transactionColumns+`
+		FROM transactions t
+		JOIN accounts a ON a.id = t.account_id
+		LEFT JOIN categories c ON c.id = t.category_id
+
+		WHERE 1=1
+			AND %s
+
+		ORDER BY t.created_at DESC, t.id DESC
+		LIMIT $%d
+	`, where, len(args))
+
+	var transactions []Transaction
+	err := repo.db.Querier(ctx).SelectContext(ctx, &transactions, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get transactions for uid %s: %w",
+			filter.UserID.String(),
+			err,
+		)
+	}
+
+	return transactions, nil
+}
+
+func (repo *walletRepositoryImpl) StreamTransactions(
+	ctx context.Context,
+	filter TransactionFilter,
+	fn func(tx *Transaction) error,
+) error {
+	where, args := filter.where()
+
+	query := fmt.Sprintf(`
+		SELECT `+transactionColumns+`
+		FROM transactions t
+		JOIN accounts a ON a.id = t.account_id
+		LEFT JOIN categories c ON c.id = t.category_id
+
+		WHERE 1=1
+			AND %s
+
+		ORDER BY t.created_at, t.id
+	`, where)
+
+	rows, err := repo.db.Querier(ctx).QueryxContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to stream transactions for uid %s: %w",
+			filter.UserID.String(),
+			err,
+		)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tx Transaction
+		if err := rows.StructScan(&tx); err != nil {
+			return fmt.Errorf(
+				"failed to scan transaction for uid %s: %w",
+				filter.UserID.String(),
+				err,
+			)
+		}
+
+		if err := fn(&tx); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf(
+			"failed to stream transactions for uid %s: %w",
+			filter.UserID.String(),
+			err,
+		)
+	}
+
+	return nil
+}
+
 func (repo *walletRepositoryImpl) CreateTransaction(
 	ctx context.Context,
 	tx *Transaction,
 ) (*Transaction, error) {
 	query := `
-		INSERT INTO transactions (
-			id,
-			account_id,
-			to_account_id,
-			type,
-			amount,
-			currency,
-			mcc,
-			description,
-			created_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING id, account_id, to_account_id, type, amount, currency, mcc, description, created_at
+		WITH t AS (
+			INSERT INTO transactions (
+				id,
+				account_id,
+				to_account_id,
+				type,
+				amount,
+				currency,
+				mcc,
+				description,
+				created_at,
+				category_id,
+				import_fingerprint,
+				external_id,
+				recurring_id,
+				occurrence,
+				transfer_id,
+				from_account_id
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, ` + categoryIDOrMCC + `, $11, $12, $13, $14, $15, $16)
+			RETURNING *
+		)
+		SELECT ` + transactionColumns + `
+		FROM t
+		LEFT JOIN categories c ON c.id = t.category_id
 	`
 
 	tx.ID = uuid.New()
 
-	err := repo.db.GetDB().GetContext(
+	err := repo.db.Querier(ctx).GetContext(
 		ctx,
 		tx,
 		query,
@@ -152,8 +502,19 @@ func (repo *walletRepositoryImpl) CreateTransaction(
 		tx.MCC,
 		tx.Description,
 		tx.CreatedAt,
+		tx.CategoryID,
+		tx.ImportFingerprint,
+		tx.ExternalID,
+		tx.RecurringID,
+		tx.Occurrence,
+		tx.TransferID,
+		tx.FromAccountID,
 	)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			err = ErrTransactionExists
+		}
 		return nil, fmt.Errorf(
 			"failed to create transaction for aid %s: %w",
 			tx.AccountID.String(),
@@ -164,6 +525,166 @@ func (repo *walletRepositoryImpl) CreateTransaction(
 	return tx, nil
 }
 
+func (repo *walletRepositoryImpl) GetTransactionForUpdate(
+	ctx context.Context,
+	transactionID uuid.UUID,
+) (*Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON c.id = t.category_id
+
+		WHERE 1=1
+			AND t.id = $1
+
+		FOR UPDATE OF t
+	`
+
+	var tx Transaction
+	err := repo.db.Querier(ctx).GetContext(ctx, &tx, query, transactionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(
+			"failed to get transaction %s: %w",
+			transactionID.String(),
+			ErrTransactionNotFound,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get transaction %s: %w",
+			transactionID.String(),
+			err,
+		)
+	}
+
+	return &tx, nil
+}
+
+func (repo *walletRepositoryImpl) UpdateTransaction(
+	ctx context.Context,
+	tx *Transaction,
+) (*Transaction, error) {
+	query := `
+		WITH t AS (
+			UPDATE transactions
+			SET
+				account_id = $2,
+				to_account_id = $3,
+				type = $4,
+				amount = $5,
+				currency = $6,
+				mcc = $7,
+				description = $8,
+				created_at = $9,
+				category_id = ` + categoryIDOrMCC + `,
+				transfer_id = $11,
+				from_account_id = $12
+			WHERE id = $1
+			RETURNING *
+		)
+		SELECT ` + transactionColumns + `
+		FROM t
+		LEFT JOIN categories c ON c.id = t.category_id
+	`
+
+	err := repo.db.Querier(ctx).GetContext(
+		ctx,
+		tx,
+		query,
+		tx.ID,
+		tx.AccountID,
+		tx.ToAccountID,
+		tx.Type,
+		tx.Amount,
+		tx.Currency,
+		tx.MCC,
+		tx.Description,
+		tx.CreatedAt,
+		tx.CategoryID,
+		tx.TransferID,
+		tx.FromAccountID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(
+			"failed to update transaction %s: %w",
+			tx.ID.String(),
+			ErrTransactionNotFound,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to update transaction %s: %w",
+			tx.ID.String(),
+			err,
+		)
+	}
+
+	return tx, nil
+}
+
+func (repo *walletRepositoryImpl) UpdateTransactionDetails(
+	ctx context.Context,
+	transactionID uuid.UUID,
+	categoryID uuid.NullUUID,
+	description sql.NullString,
+) error {
+	query := `
+		UPDATE transactions
+		SET
+			category_id = $2,
+			description = $3
+		WHERE id = $1
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, transactionID, categoryID, description)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to update transaction %s: %w",
+			transactionID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to update transaction %s: %w",
+			transactionID.String(),
+			ErrTransactionNotFound,
+		)
+	}
+
+	return nil
+}
+
+func (repo *walletRepositoryImpl) DeleteTransaction(
+	ctx context.Context,
+	transactionID uuid.UUID,
+) error {
+	query := `
+		DELETE FROM transactions
+		WHERE id = $1
+	`
+
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, transactionID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to delete transaction %s: %w",
+			transactionID.String(),
+			err,
+		)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to delete transaction %s: %w",
+			transactionID.String(),
+			ErrTransactionNotFound,
+		)
+	}
+
+	return nil
+}
+
 func (repo *walletRepositoryImpl) UpdateAccountBalance(
 	ctx context.Context,
 	accountID uuid.UUID,
@@ -175,7 +696,7 @@ func (repo *walletRepositoryImpl) UpdateAccountBalance(
 		WHERE id = $2
 	`
 
-	_, err := repo.db.GetDB().ExecContext(ctx, query, amount, accountID)
+	res, err := repo.db.Querier(ctx).ExecContext(ctx, query, amount, accountID)
 	if err != nil {
 		return fmt.Errorf(
 			"failed to update account balance for aid %s and amount %d: %w",
@@ -185,5 +706,21 @@ func (repo *walletRepositoryImpl) UpdateAccountBalance(
 		)
 	}
 
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf(
+			"failed to update account balance for aid %s: %w",
+			accountID.String(),
+			ErrAccountNotFound,
+		)
+	}
+
 	return nil
 }
+
+func uniqueIDs(ids []uuid.UUID) map[uuid.UUID]struct{} {
+	set := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,135 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// splitColumns selects transaction splits s left joined with their
+// categories c.
+const splitColumns = `
+	s.id,
+	s.transaction_id,
+	s.position,
+	s.category_id,
+	s.mcc,
+	s.amount,
+	s.note,
+	c.name AS category_name
+`
+
+func (repo *walletRepositoryImpl) GetSplits(
+	ctx context.Context,
+	transactionIDs []uuid.UUID,
+) (map[uuid.UUID][]Split, error) {
+	if len(transactionIDs) == 0 {
+		return map[uuid.UUID][]Split{}, nil
+	}
+
+	ids := make([]string, 0, len(transactionIDs))
+	for _, id := range transactionIDs {
+		ids = append(ids, id.String())
+	}
+
+	query := `
+		SELECT ` + splitColumns + `
+		FROM transaction_splits s
+		LEFT JOIN categories c ON c.id = s.category_id
+
+		WHERE 1=1
+			AND s.transaction_id = ANY($1::uuid[])
+
+		ORDER BY s.transaction_id, s.position
+	`
+
+	var splits []Split
+	err := repo.db.Querier(ctx).SelectContext(ctx, &splits, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction splits: %w", err)
+	}
+
+	byTransaction := make(map[uuid.UUID][]Split)
+	for _, split := range splits {
+		byTransaction[split.TransactionID] = append(byTransaction[split.TransactionID], split)
+	}
+
+	return byTransaction, nil
+}
+
+func (repo *walletRepositoryImpl) ReplaceSplits(
+	ctx context.Context,
+	transactionID uuid.UUID,
+	splits []Split,
+) ([]Split, error) {
+	deleteQuery := `
+		DELETE FROM transaction_splits
+		WHERE 1=1
+			AND transaction_id = $1
+	`
+
+	_, err := repo.db.Querier(ctx).ExecContext(ctx, deleteQuery, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to delete splits of transaction %s: %w",
+			transactionID.String(),
+			err,
+		)
+	}
+
+	// like transactions, a split with only an MCC is put into the category
+	// mapped to it
+	insertQuery := `
+		WITH s AS (
+			INSERT INTO transaction_splits (
+				id,
+				transaction_id,
+				position,
+				category_id,
+				mcc,
+				amount,
+				note
+			) VALUES ($1, $2, $3, COALESCE(
+				$4::uuid,
+				(
+					SELECT m.category_id
+					FROM mcc_categories m
+					WHERE $5::int BETWEEN m.mcc_from AND m.mcc_to
+					LIMIT 1
+				)
+			), $5, $6, $7)
+			RETURNING *
+		)
+		SELECT ` + splitColumns + `
+		FROM s
+		LEFT JOIN categories c ON c.id = s.category_id
+	`
+
+	created := make([]Split, 0, len(splits))
+	for i, split := range splits {
+		var stored Split
+		err := repo.db.Querier(ctx).GetContext(
+			ctx,
+			&stored,
+			insertQuery,
+			uuid.New(),
+			transactionID,
+			i,
+			split.CategoryID,
+			split.MCC,
+			split.Amount,
+			split.Note,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to create split of transaction %s: %w",
+				transactionID.String(),
+				err,
+			)
+		}
+		created = append(created, stored)
+	}
+
+	return created, nil
+}
@@ -0,0 +1,82 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func (repo *walletRepositoryImpl) GetTransferLegForUpdate(
+	ctx context.Context,
+	tx *Transaction,
+) (*Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON c.id = t.category_id
+
+		WHERE 1=1
+			AND t.transfer_id = $1
+			AND t.id <> $2
+
+		FOR UPDATE OF t
+	`
+
+	var leg Transaction
+	err := repo.db.Querier(ctx).GetContext(ctx, &leg, query, tx.TransferID, tx.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(
+			"failed to get other leg of transaction %s: %w",
+			tx.ID.String(),
+			ErrTransactionNotFound,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get other leg of transaction %s: %w",
+			tx.ID.String(),
+			err,
+		)
+	}
+
+	return &leg, nil
+}
+
+func (repo *walletRepositoryImpl) GetTransferLegs(
+	ctx context.Context,
+	transferIDs []uuid.UUID,
+) (map[uuid.UUID][]Transaction, error) {
+	if len(transferIDs) == 0 {
+		return map[uuid.UUID][]Transaction{}, nil
+	}
+
+	ids := make([]string, 0, len(transferIDs))
+	for _, id := range transferIDs {
+		ids = append(ids, id.String())
+	}
+
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON c.id = t.category_id
+
+		WHERE 1=1
+			AND t.transfer_id = ANY($1::uuid[])
+	`
+
+	var legs []Transaction
+	err := repo.db.Querier(ctx).SelectContext(ctx, &legs, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer legs: %w", err)
+	}
+
+	byTransfer := make(map[uuid.UUID][]Transaction)
+	for _, leg := range legs {
+		byTransfer[leg.TransferID.UUID] = append(byTransfer[leg.TransferID.UUID], leg)
+	}
+
+	return byTransfer, nil
+}
@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const (
+	keySize = 32
+)
+
+var (
+	ErrInvalidKey          = errors.New("encryption key must be 32 bytes encoded in base64")
+	ErrMalformedCipherText = errors.New("malformed cipher text")
+	ErrNoKey               = errors.New("encryption key is not configured")
+)
+
+// Cipher encrypts secrets before they are stored in the database.
+type Cipher interface {
+	Encrypt(plainText []byte) ([]byte, error)
+	Decrypt(cipherText []byte) ([]byte, error)
+}
+
+type aesCipher struct {
+	aead cipher.AEAD
+}
+
+// NewAESCipher builds an AES-256-GCM cipher from a base64 encoded key. The
+// random nonce is prepended to every cipher text.
+func NewAESCipher(encodedKey string) (Cipher, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) != keySize {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return &aesCipher{
+		aead: aead,
+	}, nil
+}
+
+// NoKeyCipher stands in for a cipher whose key is not configured: every
+// call fails with ErrNoKey, so only the features storing secrets are
+// unavailable.
+var NoKeyCipher Cipher = noKeyCipher{}
+
+type noKeyCipher struct{}
+
+func (noKeyCipher) Encrypt([]byte) ([]byte, error) { return nil, ErrNoKey }
+func (noKeyCipher) Decrypt([]byte) ([]byte, error) { return nil, ErrNoKey }
+
+func (c *aesCipher) Encrypt(plainText []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return c.aead.Seal(nonce, nonce, plainText, nil), nil
+}
+
+func (c *aesCipher) Decrypt(cipherText []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(cipherText) < nonceSize {
+		return nil, ErrMalformedCipherText
+	}
+
+	plainText, err := c.aead.Open(nil, cipherText[:nonceSize], cipherText[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+
+	return plainText, nil
+}
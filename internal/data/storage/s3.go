@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"backend-master/configs"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// s3Storage keeps objects in a bucket of an S3-compatible service.
+type s3Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewS3Storage(cfg configs.StorageConfig) (BlobStorage, error) {
+	client, err := minio.New(cfg.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.S3AccessKey, cfg.S3SecretKey, ""),
+		Secure: cfg.S3UseSSL,
+		Region: cfg.S3Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client for %s: %w", cfg.S3Endpoint, err)
+	}
+
+	return &s3Storage{
+		client: client,
+		bucket: cfg.S3Bucket,
+	}, nil
+}
+
+func (s *s3Storage) Put(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	contentType string,
+) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put blob %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *s3Storage) Get(
+	ctx context.Context,
+	key string,
+) (io.ReadCloser, error) {
+	// the object is only requested on the first read, so a missing one is
+	// detected with Stat
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blob %s: %w", key, err)
+	}
+
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("failed to get blob %s: %w", key, ErrBlobNotFound)
+		}
+		return nil, fmt.Errorf("failed to get blob %s: %w", key, err)
+	}
+
+	return obj, nil
+}
+
+func (s *s3Storage) Delete(
+	ctx context.Context,
+	key string,
+) error {
+	err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to delete blob %s: %w", key, err)
+	}
+
+	return nil
+}
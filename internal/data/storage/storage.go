@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"backend-master/configs"
+)
+
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+var (
+	ErrBlobNotFound   = errors.New("blob not found")
+	ErrUnknownBackend = errors.New("unknown storage backend")
+	ErrInvalidKey     = errors.New("blob key must be a relative path")
+)
+
+// BlobStorage keeps binary objects, such as attachment files, by key.
+type BlobStorage interface {
+	// Put stores size bytes read from r under key, replacing an existing
+	// object.
+	Put(
+		ctx context.Context,
+		key string,
+		r io.Reader,
+		size int64,
+		contentType string,
+	) error
+
+	// Get opens the object stored under key. The caller closes it.
+	Get(
+		ctx context.Context,
+		key string,
+	) (io.ReadCloser, error)
+
+	// Delete removes the object stored under key. A missing object is not
+	// an error.
+	Delete(
+		ctx context.Context,
+		key string,
+	) error
+}
+
+// New builds the storage backend selected by the config.
+func New(cfg configs.StorageConfig) (BlobStorage, error) {
+	switch cfg.Backend {
+	case BackendLocal:
+		return NewLocalStorage(cfg.LocalDir)
+	case BackendS3:
+		return NewS3Storage(cfg)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownBackend, cfg.Backend)
+	}
+}
@@ -0,0 +1,64 @@
+// Package calendar splits time into the calendar periods shared by budgets,
+// balance history and analytics, so that they all agree on where a period
+// starts and ends.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+)
+
+var ErrUnsupportedPeriod = errors.New("unsupported time period")
+
+// PeriodBounds returns the calendar period of the given kind containing at,
+// as [start, end) in UTC. Weeks start on Monday.
+func PeriodBounds(kind common.TimePeriod, at time.Time) (time.Time, time.Time, error) {
+	at = at.UTC()
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch kind {
+	case common.TimePeriod_TIME_PERIOD_DAY:
+		return day, day.AddDate(0, 0, 1), nil
+	case common.TimePeriod_TIME_PERIOD_WEEK:
+		start := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+		return start, start.AddDate(0, 0, 7), nil
+	case common.TimePeriod_TIME_PERIOD_MONTH:
+		start := time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0), nil
+	case common.TimePeriod_TIME_PERIOD_QUARTER:
+		month := time.Month((int(at.Month())-1)/3*3 + 1)
+		start := time.Date(at.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 3, 0), nil
+	case common.TimePeriod_TIME_PERIOD_YEAR:
+		start := time.Date(at.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, 0), nil
+	default:
+		return at, at, fmt.Errorf("%w: %s", ErrUnsupportedPeriod, kind.String())
+	}
+}
+
+// CountPeriods returns how many calendar periods of the given kind cover
+// [startDate, endDate), from the one containing startDate to the one
+// containing the last moment before endDate. Counting stops once it exceeds
+// limit.
+func CountPeriods(
+	kind common.TimePeriod,
+	startDate time.Time,
+	endDate time.Time,
+	limit int,
+) (int, error) {
+	_, end, err := PeriodBounds(kind, startDate)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 1
+	for ; end.Before(endDate) && count <= limit; count++ {
+		_, end, _ = PeriodBounds(kind, end)
+	}
+
+	return count, nil
+}
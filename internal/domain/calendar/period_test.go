@@ -0,0 +1,78 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCountPeriods(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      common.TimePeriod
+		startDate time.Time
+		endDate   time.Time
+		limit     int
+		want      int
+		wantErr   error
+	}{
+		{
+			name:      "partial months at both ends",
+			kind:      common.TimePeriod_TIME_PERIOD_MONTH,
+			startDate: day(2024, time.January, 31),
+			endDate:   day(2024, time.March, 2),
+			limit:     10,
+			want:      3,
+		},
+		{
+			name:      "end at a period start leaves it out",
+			kind:      common.TimePeriod_TIME_PERIOD_QUARTER,
+			startDate: day(2024, time.January, 1),
+			endDate:   day(2024, time.July, 1),
+			limit:     10,
+			want:      2,
+		},
+		{
+			name:      "weeks start on monday",
+			kind:      common.TimePeriod_TIME_PERIOD_WEEK,
+			startDate: day(2024, time.January, 7), // a Sunday
+			endDate:   day(2024, time.January, 9),
+			limit:     10,
+			want:      2,
+		},
+		{
+			name:      "stops past the limit",
+			kind:      common.TimePeriod_TIME_PERIOD_DAY,
+			startDate: day(2024, time.January, 1),
+			endDate:   day(2025, time.January, 1),
+			limit:     5,
+			want:      6,
+		},
+		{
+			name:      "unspecified period",
+			kind:      common.TimePeriod_TIME_PERIOD_UNSPECIFIED,
+			startDate: day(2024, time.January, 1),
+			endDate:   day(2024, time.February, 1),
+			limit:     10,
+			wantErr:   ErrUnsupportedPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CountPeriods(tt.kind, tt.startDate, tt.endDate, tt.limit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CountPeriods() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CountPeriods() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,11 @@ import (
 )
 
 type AnalyzerController interface {
+	// GetStatistics groups the window by groupBy. Missing dates default to
+	// the last year and a missing groupBy to months, or coarser periods for
+	// very long windows. The analyzer groups by month at the finest, so DAY
+	// and WEEK fail with ErrUnsupportedPeriod, as in GetForecast and
+	// GetAnomalies.
 	GetStatistics(
 		ctx context.Context,
 		userID string,
@@ -29,6 +34,17 @@ type AnalyzerController interface {
 		period common.TimePeriod,
 		periodsAhead int32,
 	) (*pb.GetForecastResponse, error)
+
+	GetAnomalies(
+		ctx context.Context,
+		userID string,
+		period common.TimePeriod,
+	) (*pb.GetAnomaliesResponse, error)
+
+	GetUpcomingRecurring(
+		ctx context.Context,
+		userID string,
+	) (*pb.GetUpcomingRecurringResponse, error)
 }
 
 type analyzerControllerImpl struct {
@@ -58,6 +74,11 @@ func (cont *analyzerControllerImpl) GetStatistics(
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	startDate, endDate, groupBy, err = statisticsWindow(startDate, endDate, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := cont.client.GetStatistics(
 		ctx,
 		&pb.GetStatisticsRequest{
@@ -89,6 +110,10 @@ func (cont *analyzerControllerImpl) GetForecast(
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	if err := checkAnalyzerPeriod(period); err != nil {
+		return nil, err
+	}
+
 	resp, err := cont.client.GetForecast(
 		ctx,
 		&pb.GetForecastRequest{
@@ -103,3 +128,56 @@ func (cont *analyzerControllerImpl) GetForecast(
 
 	return resp, nil
 }
+
+func (cont *analyzerControllerImpl) GetAnomalies(
+	ctx context.Context,
+	userID string,
+	period common.TimePeriod,
+) (*pb.GetAnomaliesResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if period == common.TimePeriod_TIME_PERIOD_UNSPECIFIED {
+		period = common.TimePeriod_TIME_PERIOD_MONTH
+	}
+	if err := checkAnalyzerPeriod(period); err != nil {
+		return nil, err
+	}
+
+	resp, err := cont.client.GetAnomalies(
+		ctx,
+		&pb.GetAnomaliesRequest{
+			UserId: uid.String(),
+			Period: period,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anomalies from analyzer: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (cont *analyzerControllerImpl) GetUpcomingRecurring(
+	ctx context.Context,
+	userID string,
+) (*pb.GetUpcomingRecurringResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	resp, err := cont.client.GetUpcomingRecurring(
+		ctx,
+		&pb.GetUpcomingRecurringRequest{
+			UserId: uid.String(),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming recurring payments from analyzer: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	"backend-master/internal/domain/calendar"
+)
+
+const (
+	// maxStatisticsBuckets caps how many periods a single statistics request
+	// may be split into, e.g. a year of days.
+	maxStatisticsBuckets = 366
+
+	defaultStatisticsWindow = 1 // years
+)
+
+var (
+	ErrInvalidWindow     = errors.New("start date must be before end date")
+	ErrTooManyBuckets    = fmt.Errorf("window is split into more than %d periods, use a coarser group_by", maxStatisticsBuckets)
+	ErrUnsupportedPeriod = calendar.ErrUnsupportedPeriod
+)
+
+// statisticsWindow fills in a missing window and granularity and checks that
+// the window does not produce too many buckets.
+func statisticsWindow(
+	startDate time.Time,
+	endDate time.Time,
+	groupBy common.TimePeriod,
+) (time.Time, time.Time, common.TimePeriod, error) {
+	if endDate.IsZero() {
+		endDate = time.Now()
+	}
+	if startDate.IsZero() {
+		startDate = endDate.AddDate(-defaultStatisticsWindow, 0, 0)
+	}
+	if !startDate.Before(endDate) {
+		return startDate, endDate, groupBy, ErrInvalidWindow
+	}
+
+	if groupBy == common.TimePeriod_TIME_PERIOD_UNSPECIFIED {
+		groupBy = defaultGroupBy(startDate, endDate)
+	}
+	if err := checkAnalyzerPeriod(groupBy); err != nil {
+		return startDate, endDate, groupBy, err
+	}
+
+	count, err := calendar.CountPeriods(groupBy, startDate, endDate, maxStatisticsBuckets)
+	if err != nil {
+		return startDate, endDate, groupBy, err
+	}
+	if count > maxStatisticsBuckets {
+		return startDate, endDate, groupBy, ErrTooManyBuckets
+	}
+
+	return startDate, endDate, groupBy, nil
+}
+
+// defaultGroupBy groups by month, falling back to coarser periods only for
+// windows too long to fit maxStatisticsBuckets months.
+func defaultGroupBy(startDate time.Time, endDate time.Time) common.TimePeriod {
+	for _, groupBy := range []common.TimePeriod{
+		common.TimePeriod_TIME_PERIOD_MONTH,
+		common.TimePeriod_TIME_PERIOD_QUARTER,
+	} {
+		if count, _ := calendar.CountPeriods(groupBy, startDate, endDate, maxStatisticsBuckets); count <= maxStatisticsBuckets {
+			return groupBy
+		}
+	}
+	return common.TimePeriod_TIME_PERIOD_YEAR
+}
+
+// checkAnalyzerPeriod rejects the periods the analyzer service does not
+// group by. DAY and WEEK are only computed locally, e.g. by budgets and
+// balance history.
+func checkAnalyzerPeriod(period common.TimePeriod) error {
+	switch period {
+	case common.TimePeriod_TIME_PERIOD_DAY, common.TimePeriod_TIME_PERIOD_WEEK:
+		return fmt.Errorf("%w: %s, the analyzer groups by month at the finest", ErrUnsupportedPeriod, period.String())
+	default:
+		return nil
+	}
+}
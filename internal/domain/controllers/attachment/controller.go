@@ -0,0 +1,239 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"backend-master/configs"
+	"backend-master/internal/data/repositories/attachment"
+	"backend-master/internal/data/storage"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidAttachment  = errors.New("invalid attachment")
+	ErrEmptyFile          = fmt.Errorf("%w: file is empty", ErrInvalidAttachment)
+	ErrAttachmentTooLarge = fmt.Errorf("%w: file is too large", ErrInvalidAttachment)
+	ErrUnsupportedType    = fmt.Errorf("%w: only JPEG, PNG, WebP, GIF and PDF files are accepted", ErrInvalidAttachment)
+)
+
+// allowedContentTypes are the types of files accepted as attachments: photos
+// and scans of receipts.
+var allowedContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"image/gif":       true,
+	"application/pdf": true,
+}
+
+type AttachmentController interface {
+	// Upload attaches the file read from r to one of the user's
+	// transactions. The content type is detected from the content, the
+	// declared one is not trusted. Rejected files give errors wrapping
+	// ErrInvalidAttachment, as do malformed IDs in all methods.
+	Upload(
+		ctx context.Context,
+		userID string,
+		transactionID string,
+		fileName string,
+		r io.Reader,
+	) (*attachment.Attachment, error)
+
+	// Download opens the content of one of the user's attachments. The
+	// caller closes it.
+	Download(
+		ctx context.Context,
+		userID string,
+		attachmentID string,
+	) (*attachment.Attachment, io.ReadCloser, error)
+
+	Delete(
+		ctx context.Context,
+		userID string,
+		attachmentID string,
+	) error
+
+	// MaxSize returns the size limit of an attachment in bytes.
+	MaxSize() int64
+}
+
+type attachmentControllerImpl struct {
+	repo    attachment.AttachmentRepository
+	storage storage.BlobStorage
+	maxSize int64
+	logger  *zap.Logger
+}
+
+func NewController(
+	repo attachment.AttachmentRepository,
+	blobStorage storage.BlobStorage,
+	cfg configs.StorageConfig,
+	logger *zap.Logger,
+) AttachmentController {
+	return &attachmentControllerImpl{
+		repo:    repo,
+		storage: blobStorage,
+		maxSize: cfg.MaxAttachmentSize,
+		logger:  logger,
+	}
+}
+
+func (cont *attachmentControllerImpl) MaxSize() int64 {
+	return cont.maxSize
+}
+
+func (cont *attachmentControllerImpl) Upload(
+	ctx context.Context,
+	userID string,
+	transactionID string,
+	fileName string,
+	r io.Reader,
+) (*attachment.Attachment, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid user ID: %w", ErrInvalidAttachment, err)
+	}
+	txID, err := uuid.Parse(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction ID: %w", ErrInvalidAttachment, err)
+	}
+
+	// one byte over the limit is enough to tell the file is too large
+	content, err := io.ReadAll(io.LimitReader(r, cont.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(content) == 0 {
+		return nil, ErrEmptyFile
+	}
+	if int64(len(content)) > cont.maxSize {
+		return nil, fmt.Errorf("%w: the limit is %d bytes", ErrAttachmentTooLarge, cont.maxSize)
+	}
+
+	contentType := http.DetectContentType(content)
+	if !allowedContentTypes[contentType] {
+		return nil, fmt.Errorf("%w, got %s", ErrUnsupportedType, contentType)
+	}
+
+	id := uuid.New()
+	meta := attachment.Attachment{
+		ID:            id,
+		TransactionID: txID,
+		FileName:      cleanFileName(fileName),
+		ContentType:   contentType,
+		Size:          int64(len(content)),
+		StorageKey:    fmt.Sprintf("attachments/%s/%s", uid.String(), id.String()),
+	}
+
+	var (
+		created *attachment.Attachment
+		stored  bool
+	)
+	// the row is rolled back when the content cannot be stored
+	err = cont.repo.WithinTx(ctx, func(ctx context.Context) error {
+		created, err = cont.repo.CreateAttachment(ctx, uid, meta)
+		if err != nil {
+			return fmt.Errorf("failed to create attachment in repository: %w", err)
+		}
+
+		err = cont.storage.Put(ctx, meta.StorageKey, bytes.NewReader(content), meta.Size, contentType)
+		if err != nil {
+			return fmt.Errorf("failed to store attachment content: %w", err)
+		}
+		stored = true
+
+		return nil
+	})
+	if err != nil {
+		// and the content when the row cannot be committed
+		if stored {
+			cont.deleteBlob(ctx, meta.StorageKey)
+		}
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (cont *attachmentControllerImpl) Download(
+	ctx context.Context,
+	userID string,
+	attachmentID string,
+) (*attachment.Attachment, io.ReadCloser, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid user ID: %w", ErrInvalidAttachment, err)
+	}
+	id, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid attachment ID: %w", ErrInvalidAttachment, err)
+	}
+
+	meta, err := cont.repo.GetAttachment(ctx, uid, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get attachment from repository: %w", err)
+	}
+
+	content, err := cont.storage.Get(ctx, meta.StorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get attachment content: %w", err)
+	}
+
+	return meta, content, nil
+}
+
+func (cont *attachmentControllerImpl) Delete(
+	ctx context.Context,
+	userID string,
+	attachmentID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid user ID: %w", ErrInvalidAttachment, err)
+	}
+	id, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid attachment ID: %w", ErrInvalidAttachment, err)
+	}
+
+	meta, err := cont.repo.DeleteAttachment(ctx, uid, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment from repository: %w", err)
+	}
+
+	cont.deleteBlob(ctx, meta.StorageKey)
+
+	return nil
+}
+
+// deleteBlob removes the content of an attachment without a row. The
+// attachment is gone for the user already, a leftover blob only takes space,
+// so a failure is just logged.
+func (cont *attachmentControllerImpl) deleteBlob(ctx context.Context, key string) {
+	if err := cont.storage.Delete(ctx, key); err != nil {
+		cont.logger.Error(
+			"failed to delete attachment content",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+	}
+}
+
+// cleanFileName keeps the base name of an uploaded file, which is only shown
+// back to the user.
+func cleanFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, `\`, "/"))
+	if name == "." || name == "/" {
+		return "attachment"
+	}
+	return name
+}
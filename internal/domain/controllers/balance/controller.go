@@ -0,0 +1,275 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	pb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/repositories/wallet"
+	"backend-master/internal/domain/controllers/currency"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type BalanceController interface {
+	// GetBalanceHistory returns the balances of the user's accounts at the
+	// end of every period of the interval in the window, with totals
+	// converted to targetCurrency at the rates of the period ends. Accounts
+	// are left out of the periods that end before they were created.
+	GetBalanceHistory(
+		ctx context.Context,
+		userID string,
+		interval common.TimePeriod,
+		startDate time.Time,
+		endDate time.Time,
+		targetCurrency string,
+	) (*pb.GetBalanceHistoryResponse, error)
+
+	// GetBalanceAt returns the balances of the user's accounts at the moment
+	// date, that is without the transactions made at or after it.
+	GetBalanceAt(
+		ctx context.Context,
+		userID string,
+		date time.Time,
+		targetCurrency string,
+	) (*pb.GetBalanceAtResponse, error)
+}
+
+type balanceControllerImpl struct {
+	walletRepo   wallet.WalletRepository
+	currencyCtrl currency.CurrencyController
+	logger       *zap.Logger
+}
+
+func NewController(
+	walletRepo wallet.WalletRepository,
+	currencyCtrl currency.CurrencyController,
+	logger *zap.Logger,
+) BalanceController {
+	return &balanceControllerImpl{
+		walletRepo:   walletRepo,
+		currencyCtrl: currencyCtrl,
+		logger:       logger,
+	}
+}
+
+func (cont *balanceControllerImpl) GetBalanceHistory(
+	ctx context.Context,
+	userID string,
+	interval common.TimePeriod,
+	startDate time.Time,
+	endDate time.Time,
+	targetCurrency string,
+) (*pb.GetBalanceHistoryResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	periods, err := historyPeriods(startDate, endDate, interval)
+	if err != nil {
+		return nil, err
+	}
+	targetCurrency, err = currency.NormalizeCurrency(targetCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, changes, err := cont.replayFrom(ctx, uid, periods[0].end)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[uuid.UUID]int64, len(accounts))
+	for _, acc := range accounts {
+		balances[acc.ID] = acc.Balance
+	}
+
+	// balances are walked back from the current ones, undoing the changes
+	// made on the days after each period
+	now := time.Now()
+	points := make([]*pb.BalanceHistoryPoint, len(periods))
+	next := len(changes) - 1
+	for i := len(periods) - 1; i >= 0; i-- {
+		for ; next >= 0 && !changes[next].Day.Before(periods[i].end); next-- {
+			balances[changes[next].AccountID] -= changes[next].Amount
+		}
+
+		points[i], err = cont.historyPoint(ctx, periods[i], accounts, balances, targetCurrency, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.GetBalanceHistoryResponse{
+		Points: points,
+	}, nil
+}
+
+func (cont *balanceControllerImpl) GetBalanceAt(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	targetCurrency string,
+) (*pb.GetBalanceAtResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	targetCurrency, err = currency.NormalizeCurrency(targetCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	accounts, changes, err := cont.replayFrom(ctx, uid, date)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[uuid.UUID]int64, len(accounts))
+	for _, acc := range accounts {
+		balances[acc.ID] = acc.Balance
+	}
+	for _, change := range changes {
+		balances[change.AccountID] -= change.Amount
+	}
+
+	resp := &pb.GetBalanceAtResponse{}
+	var total int64
+	for _, acc := range accounts {
+		if acc.CreatedAt.After(date) {
+			continue
+		}
+
+		balance := &common.Money{
+			Amount:   balances[acc.ID],
+			Currency: acc.Currency,
+		}
+
+		conversion, err := cont.currencyCtrl.Convert(
+			ctx,
+			balance.Amount,
+			balance.Currency,
+			targetCurrency,
+			rateDate(date, time.Now()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert balance of account %s: %w", acc.ID.String(), err)
+		}
+
+		total += conversion.Amount
+		resp.AccountBalances = append(resp.AccountBalances, &pb.AccountBalance{
+			AccountId: acc.ID.String(),
+			Balance:   balance,
+			ConvertedBalance: &common.Money{
+				Amount:   conversion.Amount,
+				Currency: conversion.Currency,
+			},
+			Rate:     conversion.Rate.FloatString(6),
+			RateDate: timestamppb.New(conversion.RateDate),
+		})
+	}
+
+	resp.TotalBalance = &common.Money{
+		Amount:   total,
+		Currency: targetCurrency,
+	}
+
+	return resp, nil
+}
+
+// replayFrom returns the user's accounts with their current balances and the
+// balance changes made since the moment, which undone give the balances at
+// that moment.
+func (cont *balanceControllerImpl) replayFrom(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+) ([]wallet.Account, []wallet.BalanceChange, error) {
+	accounts, err := cont.walletRepo.GetAccountsByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get accounts from repository: %w", err)
+	}
+
+	changes, err := cont.walletRepo.GetBalanceChanges(ctx, userID, since)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get balance changes from repository: %w", err)
+	}
+
+	return accounts, changes, nil
+}
+
+// historyPoint totals the balances at the end of the period. Balances are
+// summed per currency first, so every currency is converted once.
+func (cont *balanceControllerImpl) historyPoint(
+	ctx context.Context,
+	p period,
+	accounts []wallet.Account,
+	balances map[uuid.UUID]int64,
+	targetCurrency string,
+	now time.Time,
+) (*pb.BalanceHistoryPoint, error) {
+	point := &pb.BalanceHistoryPoint{
+		PeriodStart: timestamppb.New(p.start),
+		PeriodEnd:   timestamppb.New(p.end),
+	}
+
+	byCurrency := map[string]int64{}
+	var currencies []string
+	for _, acc := range accounts {
+		if !acc.CreatedAt.Before(p.end) {
+			continue
+		}
+
+		if _, ok := byCurrency[acc.Currency]; !ok {
+			currencies = append(currencies, acc.Currency)
+		}
+		byCurrency[acc.Currency] += balances[acc.ID]
+
+		point.Accounts = append(point.Accounts, &pb.AccountBalancePoint{
+			AccountId: acc.ID.String(),
+			Balance: &common.Money{
+				Amount:   balances[acc.ID],
+				Currency: acc.Currency,
+			},
+		})
+	}
+
+	var total int64
+	for _, cur := range currencies {
+		conversion, err := cont.currencyCtrl.Convert(
+			ctx,
+			byCurrency[cur],
+			cur,
+			targetCurrency,
+			rateDate(p.end, now),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s balances: %w", cur, err)
+		}
+		total += conversion.Amount
+	}
+
+	point.TotalBalance = &common.Money{
+		Amount:   total,
+		Currency: targetCurrency,
+	}
+
+	return point, nil
+}
+
+// rateDate takes the rates of the moment, or the latest ones for the future.
+func rateDate(at time.Time, now time.Time) time.Time {
+	if at.After(now) {
+		return now
+	}
+	return at
+}
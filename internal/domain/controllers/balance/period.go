@@ -0,0 +1,71 @@
+package balance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	"backend-master/internal/domain/calendar"
+)
+
+const (
+	// maxHistoryPoints caps how many periods a single history request may
+	// be split into, e.g. a couple of years of days.
+	maxHistoryPoints = 1000
+
+	defaultHistoryWindow = 1 // years
+)
+
+var (
+	ErrInvalidWindow     = errors.New("start date must be before end date")
+	ErrTooManyPoints     = fmt.Errorf("history has more than %d points, use a longer interval", maxHistoryPoints)
+	ErrUnsupportedPeriod = calendar.ErrUnsupportedPeriod
+)
+
+// period is a calendar period [start, end) in UTC.
+type period struct {
+	start time.Time
+	end   time.Time
+}
+
+// historyPeriods splits the window into the calendar periods of the
+// interval, from the one containing startDate to the one containing the last
+// moment before endDate. A missing window ends now and starts a year
+// earlier, a missing interval means months.
+func historyPeriods(
+	startDate time.Time,
+	endDate time.Time,
+	interval common.TimePeriod,
+) ([]period, error) {
+	if endDate.IsZero() {
+		endDate = time.Now()
+	}
+	if startDate.IsZero() {
+		startDate = endDate.AddDate(-defaultHistoryWindow, 0, 0)
+	}
+	if !startDate.Before(endDate) {
+		return nil, ErrInvalidWindow
+	}
+
+	if interval == common.TimePeriod_TIME_PERIOD_UNSPECIFIED {
+		interval = common.TimePeriod_TIME_PERIOD_MONTH
+	}
+
+	start, end, err := calendar.PeriodBounds(interval, startDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []period
+	for start.Before(endDate) {
+		if len(periods) == maxHistoryPoints {
+			return nil, ErrTooManyPoints
+		}
+		periods = append(periods, period{start: start, end: end})
+
+		start, end, _ = calendar.PeriodBounds(interval, end)
+	}
+
+	return periods, nil
+}
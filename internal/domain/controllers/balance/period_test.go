@@ -0,0 +1,183 @@
+package balance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestHistoryPeriods(t *testing.T) {
+	tests := []struct {
+		name      string
+		startDate time.Time
+		endDate   time.Time
+		interval  common.TimePeriod
+		want      []period
+		wantErr   error
+	}{
+		{
+			name:      "days",
+			startDate: day(2024, time.February, 28).Add(15 * time.Hour),
+			endDate:   day(2024, time.March, 1).Add(time.Hour),
+			interval:  common.TimePeriod_TIME_PERIOD_DAY,
+			want: []period{
+				{day(2024, time.February, 28), day(2024, time.February, 29)},
+				{day(2024, time.February, 29), day(2024, time.March, 1)},
+				{day(2024, time.March, 1), day(2024, time.March, 2)},
+			},
+		},
+		{
+			name:      "end at midnight leaves out the next day",
+			startDate: day(2024, time.February, 28),
+			endDate:   day(2024, time.March, 1),
+			interval:  common.TimePeriod_TIME_PERIOD_DAY,
+			want: []period{
+				{day(2024, time.February, 28), day(2024, time.February, 29)},
+				{day(2024, time.February, 29), day(2024, time.March, 1)},
+			},
+		},
+		{
+			name:      "weeks start on monday",
+			startDate: day(2024, time.January, 3), // a Wednesday
+			endDate:   day(2024, time.January, 10),
+			interval:  common.TimePeriod_TIME_PERIOD_WEEK,
+			want: []period{
+				{day(2024, time.January, 1), day(2024, time.January, 8)},
+				{day(2024, time.January, 8), day(2024, time.January, 15)},
+			},
+		},
+		{
+			name:      "sunday belongs to the week before",
+			startDate: day(2024, time.January, 7),
+			endDate:   day(2024, time.January, 8),
+			interval:  common.TimePeriod_TIME_PERIOD_WEEK,
+			want: []period{
+				{day(2024, time.January, 1), day(2024, time.January, 8)},
+			},
+		},
+		{
+			name:      "months by default",
+			startDate: day(2024, time.January, 31),
+			endDate:   day(2024, time.March, 15),
+			want: []period{
+				{day(2024, time.January, 1), day(2024, time.February, 1)},
+				{day(2024, time.February, 1), day(2024, time.March, 1)},
+				{day(2024, time.March, 1), day(2024, time.April, 1)},
+			},
+		},
+		{
+			name:      "quarters",
+			startDate: day(2023, time.November, 15),
+			endDate:   day(2024, time.April, 2),
+			interval:  common.TimePeriod_TIME_PERIOD_QUARTER,
+			want: []period{
+				{day(2023, time.October, 1), day(2024, time.January, 1)},
+				{day(2024, time.January, 1), day(2024, time.April, 1)},
+				{day(2024, time.April, 1), day(2024, time.July, 1)},
+			},
+		},
+		{
+			name:      "years",
+			startDate: day(2022, time.June, 1),
+			endDate:   day(2024, time.January, 1),
+			interval:  common.TimePeriod_TIME_PERIOD_YEAR,
+			want: []period{
+				{day(2022, time.January, 1), day(2023, time.January, 1)},
+				{day(2023, time.January, 1), day(2024, time.January, 1)},
+			},
+		},
+		{
+			name:      "other time zones are taken in UTC",
+			startDate: time.Date(2024, time.February, 1, 1, 0, 0, 0, time.FixedZone("MSK", 3*3600)),
+			endDate:   day(2024, time.February, 2),
+			interval:  common.TimePeriod_TIME_PERIOD_MONTH,
+			want: []period{
+				{day(2024, time.January, 1), day(2024, time.February, 1)},
+				{day(2024, time.February, 1), day(2024, time.March, 1)},
+			},
+		},
+		{
+			name:      "empty window",
+			startDate: day(2024, time.January, 2),
+			endDate:   day(2024, time.January, 2),
+			wantErr:   ErrInvalidWindow,
+		},
+		{
+			name:      "reversed window",
+			startDate: day(2024, time.January, 2),
+			endDate:   day(2024, time.January, 1),
+			wantErr:   ErrInvalidWindow,
+		},
+		{
+			name:      "too many points",
+			startDate: day(2020, time.January, 1),
+			endDate:   day(2023, time.January, 1),
+			interval:  common.TimePeriod_TIME_PERIOD_DAY,
+			wantErr:   ErrTooManyPoints,
+		},
+		{
+			name:      "unknown interval",
+			startDate: day(2024, time.January, 1),
+			endDate:   day(2024, time.February, 1),
+			interval:  common.TimePeriod(100),
+			wantErr:   ErrUnsupportedPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := historyPeriods(tt.startDate, tt.endDate, tt.interval)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("historyPeriods() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("historyPeriods() error = %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("historyPeriods() returned %d periods, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i := range tt.want {
+				if !got[i].start.Equal(tt.want[i].start) || !got[i].end.Equal(tt.want[i].end) {
+					t.Errorf(
+						"period %d = [%s, %s), want [%s, %s)",
+						i,
+						got[i].start,
+						got[i].end,
+						tt.want[i].start,
+						tt.want[i].end,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestHistoryPeriodsDefaultWindow(t *testing.T) {
+	endDate := day(2024, time.June, 15)
+
+	got, err := historyPeriods(time.Time{}, endDate, common.TimePeriod_TIME_PERIOD_UNSPECIFIED)
+	if err != nil {
+		t.Fatalf("historyPeriods() error = %v", err)
+	}
+
+	// a year back from mid June, by month
+	if len(got) != 13 {
+		t.Fatalf("historyPeriods() returned %d periods, want 13", len(got))
+	}
+	if want := day(2023, time.June, 1); !got[0].start.Equal(want) {
+		t.Errorf("first period starts %s, want %s", got[0].start, want)
+	}
+	if want := day(2024, time.July, 1); !got[len(got)-1].end.Equal(want) {
+		t.Errorf("last period ends %s, want %s", got[len(got)-1].end, want)
+	}
+}
@@ -0,0 +1,391 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/repositories/budget"
+	"backend-master/internal/domain/calendar"
+	"backend-master/internal/domain/controllers/category"
+	currencyController "backend-master/internal/domain/controllers/currency"
+	"backend-master/internal/domain/controllers/notification"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// alertThresholds are the shares of a budget limit, in percent, that trigger
+// a notification, in ascending order.
+var alertThresholds = []int{80, 100}
+
+var (
+	ErrInvalidCategory = errors.New("category ID must be a category UUID or an MCC code")
+	ErrInvalidPeriod   = errors.New("budget period must be specified")
+	ErrInvalidLimit    = errors.New("budget limit must be positive")
+)
+
+type BudgetController interface {
+	CreateBudget(
+		ctx context.Context,
+		userID string,
+		categoryID string,
+		period common.TimePeriod,
+		limit int64,
+		currency string,
+	) (*masterpb.Budget, error)
+
+	UpdateBudget(
+		ctx context.Context,
+		userID string,
+		budgetID string,
+		limit int64,
+		currency string,
+	) (*masterpb.Budget, error)
+
+	DeleteBudget(
+		ctx context.Context,
+		userID string,
+		budgetID string,
+	) error
+
+	ListBudgets(
+		ctx context.Context,
+		userID string,
+	) ([]*masterpb.Budget, error)
+
+	// GetBudgetStatus reports spending against every budget of the user for
+	// the periods containing date. Only expenses in the budget currency count.
+	GetBudgetStatus(
+		ctx context.Context,
+		userID string,
+		date time.Time,
+	) ([]*masterpb.BudgetStatus, error)
+
+	// CheckThresholds notifies the user about every budget whose alert
+	// threshold has been reached by an expense with the MCC and category,
+	// either of which may be unset. It is meant to run in the transaction
+	// that stored the expense, so the alerts and notifications are only kept
+	// if the expense is.
+	CheckThresholds(
+		ctx context.Context,
+		userID uuid.UUID,
+		mcc sql.NullInt32,
+		categoryID uuid.NullUUID,
+		currency string,
+		date time.Time,
+	) error
+}
+
+type budgetControllerImpl struct {
+	repo          budget.BudgetRepository
+	notifications notification.NotificationController
+	categories    category.CategoryController
+	logger        *zap.Logger
+}
+
+func NewController(
+	repo budget.BudgetRepository,
+	notifications notification.NotificationController,
+	categories category.CategoryController,
+	logger *zap.Logger,
+) BudgetController {
+	return &budgetControllerImpl{
+		repo:          repo,
+		notifications: notifications,
+		categories:    categories,
+		logger:        logger,
+	}
+}
+
+func (cont *budgetControllerImpl) CreateBudget(
+	ctx context.Context,
+	userID string,
+	categoryID string,
+	period common.TimePeriod,
+	limit int64,
+	currency string,
+) (*masterpb.Budget, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	b := &budget.Budget{UserID: uid}
+	if mcc, err := strconv.ParseInt(categoryID, 10, 32); err == nil {
+		b.MCC = sql.NullInt32{Int32: int32(mcc), Valid: true}
+	} else if cid, err := uuid.Parse(categoryID); err == nil {
+		b.CategoryID = uuid.NullUUID{UUID: cid, Valid: true}
+	} else {
+		return nil, ErrInvalidCategory
+	}
+
+	dbPeriod := budget.PeriodPbTypeToDbType(period)
+	if dbPeriod == "" {
+		return nil, ErrInvalidPeriod
+	}
+
+	if limit <= 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	currency, err = currencyController.NormalizeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.CategoryID.Valid {
+		if _, err := cont.categories.GetCategory(ctx, uid, b.CategoryID.UUID); err != nil {
+			return nil, fmt.Errorf("failed to check budget category: %w", err)
+		}
+	}
+
+	b.Period = dbPeriod
+	b.LimitAmount = limit
+	b.Currency = currency
+	b.CreatedAt = time.Now()
+
+	created, err := cont.repo.CreateBudget(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create budget in repository: %w", err)
+	}
+
+	return created.ToProto(), nil
+}
+
+func (cont *budgetControllerImpl) UpdateBudget(
+	ctx context.Context,
+	userID string,
+	budgetID string,
+	limit int64,
+	currency string,
+) (*masterpb.Budget, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	bid, err := uuid.Parse(budgetID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid budget ID: %w", err)
+	}
+
+	if limit <= 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	currency, err = currencyController.NormalizeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := cont.repo.UpdateBudgetLimit(ctx, uid, bid, limit, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update budget in repository: %w", err)
+	}
+
+	return updated.ToProto(), nil
+}
+
+func (cont *budgetControllerImpl) DeleteBudget(
+	ctx context.Context,
+	userID string,
+	budgetID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	bid, err := uuid.Parse(budgetID)
+	if err != nil {
+		return fmt.Errorf("invalid budget ID: %w", err)
+	}
+
+	if err := cont.repo.DeleteBudget(ctx, uid, bid); err != nil {
+		return fmt.Errorf("failed to delete budget in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (cont *budgetControllerImpl) ListBudgets(
+	ctx context.Context,
+	userID string,
+) ([]*masterpb.Budget, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	budgets, err := cont.repo.GetBudgetsByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets from repository: %w", err)
+	}
+
+	pbBudgets := make([]*masterpb.Budget, 0, len(budgets))
+	for _, b := range budgets {
+		pbBudgets = append(pbBudgets, b.ToProto())
+	}
+
+	return pbBudgets, nil
+}
+
+func (cont *budgetControllerImpl) GetBudgetStatus(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+) ([]*masterpb.BudgetStatus, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	budgets, err := cont.repo.GetBudgetsByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets from repository: %w", err)
+	}
+
+	statuses := make([]*masterpb.BudgetStatus, 0, len(budgets))
+	for _, b := range budgets {
+		start, end, err := calendar.PeriodBounds(budget.PeriodDbTypeToPbType(b.Period), date)
+		if err != nil {
+			return nil, err
+		}
+
+		spent, err := cont.repo.GetSpent(ctx, &b, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get spent amount from repository: %w", err)
+		}
+
+		statuses = append(statuses, &masterpb.BudgetStatus{
+			Budget: b.ToProto(),
+			Spent: &common.Money{
+				Amount:   spent,
+				Currency: b.Currency,
+			},
+			Remaining: &common.Money{
+				Amount:   b.LimitAmount - spent,
+				Currency: b.Currency,
+			},
+			UsedPercent: float64(spent) * 100 / float64(b.LimitAmount),
+			PeriodStart: timestamppb.New(start),
+			PeriodEnd:   timestamppb.New(end),
+		})
+	}
+
+	return statuses, nil
+}
+
+func (cont *budgetControllerImpl) CheckThresholds(
+	ctx context.Context,
+	userID uuid.UUID,
+	mcc sql.NullInt32,
+	categoryID uuid.NullUUID,
+	currency string,
+	date time.Time,
+) error {
+	if !mcc.Valid && !categoryID.Valid {
+		return nil
+	}
+
+	budgets, err := cont.repo.GetBudgetsByCategory(ctx, userID, mcc, categoryID)
+	if err != nil {
+		return fmt.Errorf("failed to get budgets from repository: %w", err)
+	}
+
+	for _, b := range budgets {
+		if b.Currency != currency {
+			continue
+		}
+
+		start, end, err := calendar.PeriodBounds(budget.PeriodDbTypeToPbType(b.Period), date)
+		if err != nil {
+			return err
+		}
+
+		spent, err := cont.repo.GetSpent(ctx, &b, start, end)
+		if err != nil {
+			return fmt.Errorf("failed to get spent amount from repository: %w", err)
+		}
+
+		// every threshold is recorded, but only the highest newly reached
+		// one is reported
+		crossed := 0
+		for _, threshold := range alertThresholds {
+			if spent*100 < b.LimitAmount*int64(threshold) {
+				break
+			}
+
+			created, err := cont.repo.CreateAlert(ctx, b.ID, start, threshold)
+			if err != nil {
+				return fmt.Errorf("failed to record budget alert in repository: %w", err)
+			}
+			if created {
+				crossed = threshold
+			}
+		}
+
+		if crossed == 0 {
+			continue
+		}
+
+		title, message := alertText(&b, cont.categoryName(ctx, &b), crossed, spent)
+		if _, err := cont.notifications.SendNotification(ctx, userID.String(), title, message); err != nil {
+			return fmt.Errorf("failed to queue budget notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// categoryName names the category of a budget in alerts. MCC budgets and
+// categories that cannot be loaded are named by their ID.
+func (cont *budgetControllerImpl) categoryName(ctx context.Context, b *budget.Budget) string {
+	if !b.CategoryID.Valid {
+		return b.Category()
+	}
+
+	c, err := cont.categories.GetCategory(ctx, b.UserID, b.CategoryID.UUID)
+	if err != nil {
+		cont.logger.Error(
+			"failed to get budget category",
+			zap.String("budget_id", b.ID.String()),
+			zap.Error(err),
+		)
+		return b.Category()
+	}
+
+	return c.Name
+}
+
+func alertText(b *budget.Budget, category string, threshold int, spent int64) (string, string) {
+	title := "Budget almost spent"
+	if threshold >= 100 {
+		title = "Budget exceeded"
+	}
+
+	message := fmt.Sprintf(
+		"You have spent %s of your %s budget for category %s (%d%%).",
+		formatMoney(spent, b.Currency),
+		formatMoney(b.LimitAmount, b.Currency),
+		category,
+		spent*100/b.LimitAmount,
+	)
+
+	return title, message
+}
+
+func formatMoney(amount int64, currency string) string {
+	return fmt.Sprintf("%d.%02d %s", amount/100, amount%100, currency)
+}
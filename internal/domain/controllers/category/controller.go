@@ -0,0 +1,286 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	masterpb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/repositories/category"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+var (
+	ErrEmptyCategoryName = errors.New("category name must not be empty")
+	ErrInvalidColor      = errors.New("category color must be a #RRGGBB hex code")
+	ErrSystemCategory    = errors.New("system categories cannot be changed")
+	ErrCategoryCycle     = errors.New("category cannot be nested under itself or its descendants")
+)
+
+type CategoryController interface {
+	ListCategories(
+		ctx context.Context,
+		userID string,
+	) ([]*masterpb.Category, error)
+
+	// GetCategory returns a system category or one of the user's categories.
+	GetCategory(
+		ctx context.Context,
+		userID uuid.UUID,
+		categoryID uuid.UUID,
+	) (*masterpb.Category, error)
+
+	CreateCategory(
+		ctx context.Context,
+		userID string,
+		name string,
+		icon string,
+		color string,
+		parentID string,
+	) (*masterpb.Category, error)
+
+	UpdateCategory(
+		ctx context.Context,
+		userID string,
+		categoryID string,
+		name string,
+		icon string,
+		color string,
+		parentID string,
+	) (*masterpb.Category, error)
+
+	DeleteCategory(
+		ctx context.Context,
+		userID string,
+		categoryID string,
+	) error
+}
+
+type categoryControllerImpl struct {
+	repo   category.CategoryRepository
+	logger *zap.Logger
+}
+
+func NewController(
+	repo category.CategoryRepository,
+	logger *zap.Logger,
+) CategoryController {
+	return &categoryControllerImpl{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (cont *categoryControllerImpl) ListCategories(
+	ctx context.Context,
+	userID string,
+) ([]*masterpb.Category, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	categories, err := cont.repo.GetCategories(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories from repository: %w", err)
+	}
+
+	pbCategories := make([]*masterpb.Category, 0, len(categories))
+	for _, c := range categories {
+		pbCategories = append(pbCategories, c.ToProto())
+	}
+
+	return pbCategories, nil
+}
+
+func (cont *categoryControllerImpl) GetCategory(
+	ctx context.Context,
+	userID uuid.UUID,
+	categoryID uuid.UUID,
+) (*masterpb.Category, error) {
+	c, err := cont.repo.GetCategory(ctx, userID, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category from repository: %w", err)
+	}
+
+	return c.ToProto(), nil
+}
+
+func (cont *categoryControllerImpl) CreateCategory(
+	ctx context.Context,
+	userID string,
+	name string,
+	icon string,
+	color string,
+	parentID string,
+) (*masterpb.Category, error) {
+	c, err := cont.newCategory(ctx, userID, name, icon, color, parentID)
+	if err != nil {
+		return nil, err
+	}
+	c.CreatedAt = time.Now()
+
+	created, err := cont.repo.CreateCategory(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category in repository: %w", err)
+	}
+
+	return created.ToProto(), nil
+}
+
+func (cont *categoryControllerImpl) UpdateCategory(
+	ctx context.Context,
+	userID string,
+	categoryID string,
+	name string,
+	icon string,
+	color string,
+	parentID string,
+) (*masterpb.Category, error) {
+	cid, err := uuid.Parse(categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid category ID: %w", err)
+	}
+
+	c, err := cont.newCategory(ctx, userID, name, icon, color, parentID)
+	if err != nil {
+		return nil, err
+	}
+	c.ID = cid
+
+	existing, err := cont.repo.GetCategory(ctx, c.UserID.UUID, cid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category from repository: %w", err)
+	}
+	if existing.IsSystem() {
+		return nil, ErrSystemCategory
+	}
+
+	if c.ParentID.Valid {
+		if err := cont.checkNoCycle(ctx, c.UserID.UUID, cid, c.ParentID.UUID); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := cont.repo.UpdateCategory(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category in repository: %w", err)
+	}
+
+	return updated.ToProto(), nil
+}
+
+func (cont *categoryControllerImpl) DeleteCategory(
+	ctx context.Context,
+	userID string,
+	categoryID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	cid, err := uuid.Parse(categoryID)
+	if err != nil {
+		return fmt.Errorf("invalid category ID: %w", err)
+	}
+
+	existing, err := cont.repo.GetCategory(ctx, uid, cid)
+	if err != nil {
+		return fmt.Errorf("failed to get category from repository: %w", err)
+	}
+	if existing.IsSystem() {
+		return ErrSystemCategory
+	}
+
+	if err := cont.repo.DeleteCategory(ctx, uid, cid); err != nil {
+		return fmt.Errorf("failed to delete category in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (cont *categoryControllerImpl) newCategory(
+	ctx context.Context,
+	userID string,
+	name string,
+	icon string,
+	color string,
+	parentID string,
+) (*category.Category, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyCategoryName
+	}
+
+	color = strings.TrimSpace(color)
+	if color != "" && !colorPattern.MatchString(color) {
+		return nil, ErrInvalidColor
+	}
+
+	c := &category.Category{
+		UserID: uuid.NullUUID{UUID: uid, Valid: true},
+		Name:   name,
+		Icon:   strings.TrimSpace(icon),
+		Color:  strings.ToUpper(color),
+	}
+
+	if parentID != "" {
+		pid, err := uuid.Parse(parentID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parent category ID: %w", err)
+		}
+
+		if _, err := cont.repo.GetCategory(ctx, uid, pid); err != nil {
+			return nil, fmt.Errorf("failed to get parent category from repository: %w", err)
+		}
+
+		c.ParentID = uuid.NullUUID{UUID: pid, Valid: true}
+	}
+
+	return c, nil
+}
+
+// checkNoCycle walks up from the new parent and fails if it reaches the
+// category being moved.
+func (cont *categoryControllerImpl) checkNoCycle(
+	ctx context.Context,
+	userID uuid.UUID,
+	categoryID uuid.UUID,
+	parentID uuid.UUID,
+) error {
+	categories, err := cont.repo.GetCategories(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get categories from repository: %w", err)
+	}
+
+	parents := make(map[uuid.UUID]uuid.NullUUID, len(categories))
+	for _, c := range categories {
+		parents[c.ID] = c.ParentID
+	}
+
+	// the step limit only guards against hierarchies that are already broken
+	id, ok := parentID, true
+	for steps := 0; ok && steps <= len(parents); steps++ {
+		if id == categoryID {
+			return ErrCategoryCycle
+		}
+
+		parent := parents[id]
+		id, ok = parent.UUID, parent.Valid
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package currency
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// DefaultCurrency is used when a request leaves the currency out.
+	DefaultCurrency = "RUB"
+)
+
+var ErrInvalidCurrency = errors.New("currency must be a 3-letter ISO 4217 code")
+
+// NormalizeCurrency upper-cases a currency code and checks that it is made of
+// three letters. An empty code means DefaultCurrency.
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return DefaultCurrency, nil
+	}
+
+	if len(code) != 3 {
+		return "", ErrInvalidCurrency
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", ErrInvalidCurrency
+		}
+	}
+
+	return code, nil
+}
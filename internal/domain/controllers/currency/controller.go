@@ -0,0 +1,142 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"backend-master/internal/data/repositories/currency"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// PivotCurrency is used for cross rates when no direct quote is known.
+	PivotCurrency = "RUB"
+)
+
+// Conversion is the result of converting an amount of minor units.
+type Conversion struct {
+	Amount   int64
+	Currency string
+	Rate     *big.Rat
+	RateDate time.Time
+}
+
+type CurrencyController interface {
+	Convert(
+		ctx context.Context,
+		amount int64,
+		from string,
+		to string,
+		date time.Time,
+	) (*Conversion, error)
+}
+
+type currencyControllerImpl struct {
+	provider currency.RateProvider
+	logger   *zap.Logger
+}
+
+func NewController(
+	provider currency.RateProvider,
+	logger *zap.Logger,
+) CurrencyController {
+	return &currencyControllerImpl{
+		provider: provider,
+		logger:   logger,
+	}
+}
+
+func (cont *currencyControllerImpl) Convert(
+	ctx context.Context,
+	amount int64,
+	from string,
+	to string,
+	date time.Time,
+) (*Conversion, error) {
+	from = strings.ToUpper(from)
+	to = strings.ToUpper(to)
+
+	if from == to {
+		return &Conversion{
+			Amount:   amount,
+			Currency: to,
+			Rate:     big.NewRat(1, 1),
+			RateDate: date,
+		}, nil
+	}
+
+	rate, err := cont.getRate(ctx, from, to, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to %s: %w", from, to, err)
+	}
+
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate.Value)
+
+	return &Conversion{
+		Amount:   roundHalfAwayFromZero(converted),
+		Currency: to,
+		Rate:     rate.Value,
+		RateDate: rate.Date,
+	}, nil
+}
+
+// getRate falls back to a cross rate through PivotCurrency when the provider
+// has no direct quote. The older of the two rate dates is reported.
+func (cont *currencyControllerImpl) getRate(
+	ctx context.Context,
+	from string,
+	to string,
+	date time.Time,
+) (*currency.Rate, error) {
+	rate, err := cont.provider.GetRate(ctx, from, to, date)
+	if err == nil || !errors.Is(err, currency.ErrRateNotFound) {
+		return rate, err
+	}
+
+	if from == PivotCurrency || to == PivotCurrency {
+		return nil, err
+	}
+
+	fromPivot, err := cont.provider.GetRate(ctx, from, PivotCurrency, date)
+	if err != nil {
+		return nil, err
+	}
+
+	pivotTo, err := cont.provider.GetRate(ctx, PivotCurrency, to, date)
+	if err != nil {
+		return nil, err
+	}
+
+	rateDate := fromPivot.Date
+	if pivotTo.Date.Before(rateDate) {
+		rateDate = pivotTo.Date
+	}
+
+	return &currency.Rate{
+		From:  from,
+		To:    to,
+		Value: new(big.Rat).Mul(fromPivot.Value, pivotTo.Value),
+		Date:  rateDate,
+	}, nil
+}
+
+func roundHalfAwayFromZero(r *big.Rat) int64 {
+	num := new(big.Int).Abs(r.Num())
+	den := r.Denom()
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Mul(rem, big.NewInt(2)).Cmp(den) >= 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+
+	if r.Sign() < 0 {
+		quo.Neg(quo)
+	}
+
+	return quo.Int64()
+}
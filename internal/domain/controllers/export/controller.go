@@ -0,0 +1,178 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"backend-master/internal/data/repositories/wallet"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+	FormatOFX   Format = "ofx"
+)
+
+var (
+	ErrInvalidQuery    = errors.New("invalid export query")
+	ErrUnknownFormat   = fmt.Errorf("%w: format must be csv, jsonl or ofx", ErrInvalidQuery)
+	ErrAccountNotFound = fmt.Errorf("%w: account not found", ErrInvalidQuery)
+)
+
+type ExportController interface {
+	// ExportTransactions writes the user's transactions matching the query
+	// to w, oldest first, streaming them from the database. Nothing is
+	// written when the query is invalid; such errors wrap ErrInvalidQuery.
+	ExportTransactions(
+		ctx context.Context,
+		query Query,
+		w io.Writer,
+	) error
+}
+
+// Query selects the transactions to export. Zero dates and no accounts
+// disable the corresponding filter.
+type Query struct {
+	UserID     string
+	StartDate  time.Time
+	EndDate    time.Time
+	AccountIDs []string
+	Format     Format
+}
+
+type exportControllerImpl struct {
+	walletRepo wallet.WalletRepository
+	logger     *zap.Logger
+}
+
+func NewController(
+	walletRepo wallet.WalletRepository,
+	logger *zap.Logger,
+) ExportController {
+	return &exportControllerImpl{
+		walletRepo: walletRepo,
+		logger:     logger,
+	}
+}
+
+// ContentType returns the MIME type of an export format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSONL:
+		return "application/x-ndjson"
+	case FormatOFX:
+		return "application/x-ofx"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+func (cont *exportControllerImpl) ExportTransactions(
+	ctx context.Context,
+	query Query,
+	w io.Writer,
+) error {
+	uid, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid user ID: %w", ErrInvalidQuery, err)
+	}
+
+	if !query.StartDate.IsZero() && !query.EndDate.IsZero() && !query.StartDate.Before(query.EndDate) {
+		return fmt.Errorf("%w: start date must be before end date", ErrInvalidQuery)
+	}
+
+	accounts, err := cont.accounts(ctx, uid, query.AccountIDs)
+	if err != nil {
+		return err
+	}
+
+	var enc encoder
+	switch query.Format {
+	case FormatCSV:
+		enc = newCSVEncoder(w)
+	case FormatJSONL:
+		enc = newJSONLEncoder(w)
+	case FormatOFX:
+		enc = newOFXEncoder(w, query.StartDate, query.EndDate)
+	default:
+		return ErrUnknownFormat
+	}
+
+	filter := wallet.TransactionFilter{
+		UserID:    uid,
+		StartDate: query.StartDate,
+		EndDate:   query.EndDate,
+	}
+
+	if !enc.perAccount() {
+		for _, acc := range accounts {
+			filter.AccountIDs = append(filter.AccountIDs, acc.ID)
+		}
+		if err := cont.walletRepo.StreamTransactions(ctx, filter, enc.write); err != nil {
+			return fmt.Errorf("failed to export transactions: %w", err)
+		}
+		return enc.close()
+	}
+
+	for i := range accounts {
+		if err := enc.beginAccount(&accounts[i]); err != nil {
+			return err
+		}
+
+		filter.AccountIDs = []uuid.UUID{accounts[i].ID}
+		if err := cont.walletRepo.StreamTransactions(ctx, filter, enc.write); err != nil {
+			return fmt.Errorf("failed to export transactions: %w", err)
+		}
+
+		if err := enc.endAccount(&accounts[i]); err != nil {
+			return err
+		}
+	}
+
+	return enc.close()
+}
+
+// accounts returns the user's accounts with the given IDs, or all of them
+// when no IDs are given.
+func (cont *exportControllerImpl) accounts(
+	ctx context.Context,
+	userID uuid.UUID,
+	accountIDs []string,
+) ([]wallet.Account, error) {
+	accounts, err := cont.walletRepo.GetAccountsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts from repository: %w", err)
+	}
+	if len(accountIDs) == 0 {
+		return accounts, nil
+	}
+
+	byID := make(map[uuid.UUID]wallet.Account, len(accounts))
+	for _, acc := range accounts {
+		byID[acc.ID] = acc
+	}
+
+	selected := make([]wallet.Account, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		aid, err := uuid.Parse(accountID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid account ID %q: %w", ErrInvalidQuery, accountID, err)
+		}
+
+		acc, ok := byID[aid]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrAccountNotFound, accountID)
+		}
+		selected = append(selected, acc)
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,154 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"backend-master/internal/data/repositories/wallet"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// encoder writes exported transactions in one format. Formats that group
+// transactions by account get them one account at a time.
+type encoder interface {
+	perAccount() bool
+	beginAccount(acc *wallet.Account) error
+	write(tx *wallet.Transaction) error
+	endAccount(acc *wallet.Account) error
+	// close writes whatever the format needs after the last transaction
+	// and flushes the output
+	close() error
+}
+
+var csvHeader = []string{
+	"date",
+	"transaction_id",
+	"account_id",
+	"from_account_id",
+	"to_account_id",
+	"transfer_id",
+	"type",
+	"amount",
+	"currency",
+	"category_id",
+	"category",
+	"mcc",
+	"description",
+}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVEncoder(w io.Writer) *csvEncoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+func (e *csvEncoder) perAccount() bool                       { return false }
+func (e *csvEncoder) beginAccount(acc *wallet.Account) error { return nil }
+func (e *csvEncoder) endAccount(acc *wallet.Account) error   { return nil }
+
+func (e *csvEncoder) write(tx *wallet.Transaction) error {
+	if err := e.header(); err != nil {
+		return err
+	}
+
+	var fromAccountID, toAccountID, transferID string
+	if tx.FromAccountID.Valid {
+		fromAccountID = tx.FromAccountID.UUID.String()
+	}
+	if tx.ToAccountID.Valid {
+		toAccountID = tx.ToAccountID.UUID.String()
+	}
+	if tx.TransferID.Valid {
+		transferID = tx.TransferID.UUID.String()
+	}
+
+	var categoryID, mcc string
+	if tx.CategoryID.Valid {
+		categoryID = tx.CategoryID.UUID.String()
+	}
+	if tx.MCC.Valid {
+		mcc = strconv.Itoa(int(tx.MCC.Int32))
+	}
+
+	return e.w.Write([]string{
+		tx.CreatedAt.UTC().Format(time.RFC3339),
+		tx.ID.String(),
+		tx.AccountID.String(),
+		fromAccountID,
+		toAccountID,
+		transferID,
+		tx.Type,
+		formatAmount(tx.Amount),
+		tx.Currency,
+		categoryID,
+		tx.CategoryName.String,
+		mcc,
+		tx.Description.String,
+	})
+}
+
+func (e *csvEncoder) close() error {
+	if err := e.header(); err != nil {
+		return err
+	}
+
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// header writes the header once, so an empty export is still a valid CSV.
+func (e *csvEncoder) header() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	return e.w.Write(csvHeader)
+}
+
+// jsonlEncoder writes one transaction per line in the JSON form the API
+// returns them in.
+type jsonlEncoder struct {
+	w *bufio.Writer
+}
+
+func newJSONLEncoder(w io.Writer) *jsonlEncoder {
+	return &jsonlEncoder{w: bufio.NewWriter(w)}
+}
+
+func (e *jsonlEncoder) perAccount() bool                       { return false }
+func (e *jsonlEncoder) beginAccount(acc *wallet.Account) error { return nil }
+func (e *jsonlEncoder) endAccount(acc *wallet.Account) error   { return nil }
+
+func (e *jsonlEncoder) write(tx *wallet.Transaction) error {
+	line, err := protojson.Marshal(tx.ToProto())
+	if err != nil {
+		return fmt.Errorf("failed to marshal transaction %s: %w", tx.ID.String(), err)
+	}
+
+	if _, err := e.w.Write(line); err != nil {
+		return err
+	}
+	return e.w.WriteByte('\n')
+}
+
+func (e *jsonlEncoder) close() error {
+	return e.w.Flush()
+}
+
+// formatAmount renders minor units as a decimal amount, e.g. -1234 as -12.34.
+func formatAmount(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100)
+}
@@ -0,0 +1,161 @@
+package export
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"backend-master/internal/data/repositories/wallet"
+)
+
+const (
+	ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+`
+	ofxDateLayout = "20060102150405"
+
+	// ofxBankID fills the routing number OFX requires for bank accounts
+	ofxBankID = "000000000"
+
+	// ofxNameLength is how long the NAME element may be; longer
+	// descriptions also go to MEMO in full
+	ofxNameLength = 32
+)
+
+// ofxEncoder writes an OFX 2.2 document with a bank statement per account.
+type ofxEncoder struct {
+	w         *bufio.Writer
+	startDate time.Time
+	endDate   time.Time
+	now       time.Time
+	begun     bool
+}
+
+func newOFXEncoder(w io.Writer, startDate time.Time, endDate time.Time) *ofxEncoder {
+	return &ofxEncoder{
+		w:         bufio.NewWriter(w),
+		startDate: startDate,
+		endDate:   endDate,
+		now:       time.Now(),
+	}
+}
+
+func (e *ofxEncoder) perAccount() bool { return true }
+
+func (e *ofxEncoder) beginAccount(acc *wallet.Account) error {
+	if err := e.begin(); err != nil {
+		return err
+	}
+
+	start := e.startDate
+	if start.IsZero() {
+		start = acc.CreatedAt
+	}
+	end := e.endDate
+	if end.IsZero() {
+		end = e.now
+	}
+
+	e.printf("<STMTTRNRS><TRNUID>0</TRNUID>")
+	e.printf("<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+	e.printf("<STMTRS><CURDEF>%s</CURDEF>\n", escape(acc.Currency))
+	e.printf(
+		"<BANKACCTFROM><BANKID>%s</BANKID><ACCTID>%s</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>\n",
+		ofxBankID,
+		acc.ID.String(),
+	)
+	e.printf("<BANKTRANLIST><DTSTART>%s</DTSTART><DTEND>%s</DTEND>\n", ofxDate(start), ofxDate(end))
+
+	return nil
+}
+
+func (e *ofxEncoder) write(tx *wallet.Transaction) error {
+	trnType, amount := "CREDIT", tx.Amount
+	switch tx.Type {
+	case "EXPENSE":
+		trnType, amount = "DEBIT", -tx.Amount
+	case "TRANSFER":
+		trnType = "XFER"
+		if tx.IsOutgoingTransfer() {
+			amount = -tx.Amount
+		}
+	}
+
+	e.printf("<STMTTRN><TRNTYPE>%s</TRNTYPE>", trnType)
+	e.printf("<DTPOSTED>%s</DTPOSTED>", ofxDate(tx.CreatedAt))
+	e.printf("<TRNAMT>%s</TRNAMT>", formatAmount(amount))
+	e.printf("<FITID>%s</FITID>", tx.ID.String())
+
+	description := tx.Description.String
+	if description == "" {
+		description = tx.CategoryName.String
+	}
+	if description != "" {
+		name := []rune(description)
+		if len(name) > ofxNameLength {
+			name = name[:ofxNameLength]
+		}
+		e.printf("<NAME>%s</NAME>", escape(strings.TrimSpace(string(name))))
+		if len(name) < len([]rune(description)) {
+			e.printf("<MEMO>%s</MEMO>", escape(description))
+		}
+	}
+
+	_, err := e.w.WriteString("</STMTTRN>\n")
+	return err
+}
+
+func (e *ofxEncoder) endAccount(acc *wallet.Account) error {
+	e.printf("</BANKTRANLIST>\n")
+	e.printf(
+		"<LEDGERBAL><BALAMT>%s</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>\n",
+		formatAmount(acc.Balance),
+		ofxDate(e.now),
+	)
+	_, err := e.w.WriteString("</STMTRS></STMTTRNRS>\n")
+	return err
+}
+
+func (e *ofxEncoder) close() error {
+	if err := e.begin(); err != nil {
+		return err
+	}
+	if _, err := e.w.WriteString("</BANKMSGSRSV1>\n</OFX>\n"); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
+
+// begin writes the document header and sign-on response once.
+func (e *ofxEncoder) begin() error {
+	if e.begun {
+		return nil
+	}
+	e.begun = true
+
+	e.printf("%s<OFX>\n", ofxHeader)
+	e.printf("<SIGNONMSGSRSV1><SONRS>")
+	e.printf("<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>")
+	e.printf("<DTSERVER>%s</DTSERVER><LANGUAGE>ENG</LANGUAGE>", ofxDate(e.now))
+	_, err := e.w.WriteString("</SONRS></SIGNONMSGSRSV1>\n<BANKMSGSRSV1>\n")
+	return err
+}
+
+// printf writes to the buffer; write errors are sticky in bufio.Writer and
+// surface on the next WriteString or Flush.
+func (e *ofxEncoder) printf(format string, args ...any) {
+	fmt.Fprintf(e.w, format, args...)
+}
+
+func ofxDate(t time.Time) string {
+	return t.UTC().Format(ofxDateLayout) + "[0:GMT]"
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
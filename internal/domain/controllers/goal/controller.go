@@ -0,0 +1,485 @@
+package goal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	analyzerpb "backend-master/internal/api-gen/proto/analyzer"
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+	walletpb "backend-master/internal/api-gen/proto/wallet"
+	"backend-master/internal/data/repositories/goal"
+	"backend-master/internal/domain/controllers/analyzer"
+	currencyController "backend-master/internal/domain/controllers/currency"
+	"backend-master/internal/domain/controllers/wallet"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// forecastMonths is how far ahead completion dates are projected
+	forecastMonths = 24
+)
+
+var (
+	ErrEmptyGoalName        = errors.New("goal name must not be empty")
+	ErrInvalidTarget        = errors.New("goal target must be positive")
+	ErrAccountNotFound      = errors.New("linked account not found")
+	ErrAccountCurrency      = errors.New("linked account must be in the goal currency")
+	ErrGoalHasLinkedAccount = errors.New("goal progress comes from its linked account and does not take contributions")
+)
+
+type GoalController interface {
+	CreateGoal(
+		ctx context.Context,
+		userID string,
+		name string,
+		target int64,
+		currency string,
+		deadline time.Time,
+		accountID string,
+	) (*masterpb.Goal, error)
+
+	UpdateGoal(
+		ctx context.Context,
+		userID string,
+		goalID string,
+		name string,
+		target int64,
+		currency string,
+		deadline time.Time,
+		accountID string,
+	) (*masterpb.Goal, error)
+
+	DeleteGoal(
+		ctx context.Context,
+		userID string,
+		goalID string,
+	) error
+
+	// GetGoals reports progress of every goal of the user. Progress comes
+	// from the linked account balance or, without one, from the tagged
+	// contributions. A goal whose linked account was deleted reports no
+	// progress until it is linked again. Completion is projected from the
+	// analyzer's forecast of the user's monthly savings.
+	GetGoals(
+		ctx context.Context,
+		userID string,
+	) ([]*masterpb.GoalProgress, error)
+
+	// AddContribution tags a transaction as a contribution to a goal without
+	// a linked account. The transaction must be in the goal currency.
+	AddContribution(
+		ctx context.Context,
+		userID string,
+		goalID string,
+		transactionID string,
+	) error
+
+	RemoveContribution(
+		ctx context.Context,
+		userID string,
+		goalID string,
+		transactionID string,
+	) error
+}
+
+type goalControllerImpl struct {
+	repo         goal.GoalRepository
+	walletCtrl   wallet.WalletController
+	analyzerCtrl analyzer.AnalyzerController
+	currencyCtrl currencyController.CurrencyController
+	logger       *zap.Logger
+}
+
+func NewController(
+	repo goal.GoalRepository,
+	walletCtrl wallet.WalletController,
+	analyzerCtrl analyzer.AnalyzerController,
+	currencyCtrl currencyController.CurrencyController,
+	logger *zap.Logger,
+) GoalController {
+	return &goalControllerImpl{
+		repo:         repo,
+		walletCtrl:   walletCtrl,
+		analyzerCtrl: analyzerCtrl,
+		currencyCtrl: currencyCtrl,
+		logger:       logger,
+	}
+}
+
+func (cont *goalControllerImpl) CreateGoal(
+	ctx context.Context,
+	userID string,
+	name string,
+	target int64,
+	currency string,
+	deadline time.Time,
+	accountID string,
+) (*masterpb.Goal, error) {
+	g, err := cont.newGoal(ctx, userID, name, target, currency, deadline, accountID)
+	if err != nil {
+		return nil, err
+	}
+	g.CreatedAt = time.Now()
+
+	created, err := cont.repo.CreateGoal(ctx, g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create goal in repository: %w", err)
+	}
+
+	return created.ToProto(), nil
+}
+
+func (cont *goalControllerImpl) UpdateGoal(
+	ctx context.Context,
+	userID string,
+	goalID string,
+	name string,
+	target int64,
+	currency string,
+	deadline time.Time,
+	accountID string,
+) (*masterpb.Goal, error) {
+	gid, err := uuid.Parse(goalID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid goal ID: %w", err)
+	}
+
+	g, err := cont.newGoal(ctx, userID, name, target, currency, deadline, accountID)
+	if err != nil {
+		return nil, err
+	}
+	g.ID = gid
+
+	updated, err := cont.repo.UpdateGoal(ctx, g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update goal in repository: %w", err)
+	}
+
+	return updated.ToProto(), nil
+}
+
+func (cont *goalControllerImpl) DeleteGoal(
+	ctx context.Context,
+	userID string,
+	goalID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	gid, err := uuid.Parse(goalID)
+	if err != nil {
+		return fmt.Errorf("invalid goal ID: %w", err)
+	}
+
+	if err := cont.repo.DeleteGoal(ctx, uid, gid); err != nil {
+		return fmt.Errorf("failed to delete goal in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (cont *goalControllerImpl) GetGoals(
+	ctx context.Context,
+	userID string,
+) ([]*masterpb.GoalProgress, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	goals, err := cont.repo.GetGoalsByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals from repository: %w", err)
+	}
+	if len(goals) == 0 {
+		return []*masterpb.GoalProgress{}, nil
+	}
+
+	accounts, err := cont.userAccounts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the projection is best effort, goals are reported without it when the
+	// analyzer is unavailable
+	forecastResp, err := cont.analyzerCtrl.GetForecast(
+		ctx,
+		userID,
+		common.TimePeriod_TIME_PERIOD_MONTH,
+		forecastMonths,
+	)
+	if err != nil {
+		cont.logger.Warn("failed to get forecast for goal projection", zap.Error(err))
+	}
+
+	progress := make([]*masterpb.GoalProgress, 0, len(goals))
+	for _, g := range goals {
+		current, state, err := cont.currentAmount(ctx, &g, accounts)
+		if err != nil {
+			return nil, err
+		}
+
+		remaining := max(g.TargetAmount-current, 0)
+
+		p := &masterpb.GoalProgress{
+			Goal: g.ToProto(),
+			Current: &common.Money{
+				Amount:   current,
+				Currency: g.Currency,
+			},
+			Remaining: &common.Money{
+				Amount:   remaining,
+				Currency: g.Currency,
+			},
+			ProgressPercent: min(float64(current)*100/float64(g.TargetAmount), 100),
+			AccountState:    state,
+		}
+
+		var (
+			completion time.Time
+			projected  bool
+		)
+		switch {
+		case state == masterpb.GoalAccountState_GOAL_ACCOUNT_STATE_DELETED:
+			// nothing is saved towards the goal until it is linked again
+		case remaining == 0:
+			completion, projected = time.Now(), true
+		case forecastResp != nil:
+			completion, projected = cont.projectCompletion(ctx, remaining, g.Currency, forecastResp.Forecasts)
+		}
+
+		if projected {
+			p.ProjectedCompletion = timestamppb.New(completion)
+			p.OnTrack = !g.Deadline.Valid || !completion.After(g.Deadline.Time)
+		}
+
+		progress = append(progress, p)
+	}
+
+	return progress, nil
+}
+
+func (cont *goalControllerImpl) AddContribution(
+	ctx context.Context,
+	userID string,
+	goalID string,
+	transactionID string,
+) error {
+	uid, gid, tid, err := parseContribution(userID, goalID, transactionID)
+	if err != nil {
+		return err
+	}
+
+	goals, err := cont.repo.GetGoalsByUserID(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("failed to get goals from repository: %w", err)
+	}
+	for _, g := range goals {
+		if g.ID == gid && g.AccountID.Valid {
+			return ErrGoalHasLinkedAccount
+		}
+	}
+
+	if err := cont.repo.AddContribution(ctx, uid, gid, tid); err != nil {
+		return fmt.Errorf("failed to add contribution in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (cont *goalControllerImpl) RemoveContribution(
+	ctx context.Context,
+	userID string,
+	goalID string,
+	transactionID string,
+) error {
+	uid, gid, tid, err := parseContribution(userID, goalID, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if err := cont.repo.RemoveContribution(ctx, uid, gid, tid); err != nil {
+		return fmt.Errorf("failed to remove contribution in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (cont *goalControllerImpl) newGoal(
+	ctx context.Context,
+	userID string,
+	name string,
+	target int64,
+	currency string,
+	deadline time.Time,
+	accountID string,
+) (*goal.Goal, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyGoalName
+	}
+
+	if target <= 0 {
+		return nil, ErrInvalidTarget
+	}
+
+	currency, err = currencyController.NormalizeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &goal.Goal{
+		UserID:       uid,
+		Name:         name,
+		TargetAmount: target,
+		Currency:     currency,
+	}
+
+	if !deadline.IsZero() {
+		g.Deadline = sql.NullTime{Time: deadline, Valid: true}
+	}
+
+	if accountID != "" {
+		accounts, err := cont.userAccounts(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		acc, ok := accounts[accountID]
+		if !ok {
+			return nil, ErrAccountNotFound
+		}
+		if acc.GetBalance().GetCurrency() != currency {
+			return nil, ErrAccountCurrency
+		}
+
+		g.AccountID = uuid.NullUUID{UUID: uuid.MustParse(acc.AccountId), Valid: true}
+	}
+
+	return g, nil
+}
+
+func (cont *goalControllerImpl) userAccounts(
+	ctx context.Context,
+	userID string,
+) (map[string]*walletpb.Account, error) {
+	resp, err := cont.walletCtrl.GetUserAccounts(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user accounts: %w", err)
+	}
+
+	accounts := make(map[string]*walletpb.Account, len(resp.Accounts))
+	for _, acc := range resp.Accounts {
+		accounts[acc.AccountId] = acc
+	}
+
+	return accounts, nil
+}
+
+// currentAmount returns how much the goal has collected and the state of
+// its linked account. An archived account still counts with its balance, a
+// deleted one counts as nothing.
+func (cont *goalControllerImpl) currentAmount(
+	ctx context.Context,
+	g *goal.Goal,
+	accounts map[string]*walletpb.Account,
+) (int64, masterpb.GoalAccountState, error) {
+	if g.AccountID.Valid {
+		acc, ok := accounts[g.AccountID.UUID.String()]
+		switch {
+		case !ok:
+			return 0, masterpb.GoalAccountState_GOAL_ACCOUNT_STATE_DELETED, nil
+		case acc.Archived:
+			return acc.GetBalance().GetAmount(), masterpb.GoalAccountState_GOAL_ACCOUNT_STATE_ARCHIVED, nil
+		default:
+			return acc.GetBalance().GetAmount(), masterpb.GoalAccountState_GOAL_ACCOUNT_STATE_ACTIVE, nil
+		}
+	}
+
+	sum, err := cont.repo.SumContributions(ctx, g.ID, g.Currency)
+	if err != nil {
+		return 0, masterpb.GoalAccountState_GOAL_ACCOUNT_STATE_UNSPECIFIED,
+			fmt.Errorf("failed to sum goal contributions in repository: %w", err)
+	}
+
+	return sum, masterpb.GoalAccountState_GOAL_ACCOUNT_STATE_UNSPECIFIED, nil
+}
+
+// projectCompletion walks the forecast periods accumulating the expected
+// balance, i.e. savings, and returns the end of the period in which they
+// cover the remaining amount.
+func (cont *goalControllerImpl) projectCompletion(
+	ctx context.Context,
+	remaining int64,
+	goalCurrency string,
+	forecasts []*analyzerpb.Forecast,
+) (time.Time, bool) {
+	var saved int64
+	for _, f := range forecasts {
+		balance := f.GetExpectedBalance()
+		if balance == nil {
+			continue
+		}
+
+		amount := balance.Amount
+		if balance.Currency != "" && balance.Currency != goalCurrency {
+			conversion, err := cont.currencyCtrl.Convert(
+				ctx,
+				amount,
+				balance.Currency,
+				goalCurrency,
+				f.GetPeriodStart().AsTime(),
+			)
+			if err != nil {
+				cont.logger.Warn("failed to convert forecast for goal projection", zap.Error(err))
+				return time.Time{}, false
+			}
+			amount = conversion.Amount
+		}
+
+		saved += amount
+		if saved >= remaining {
+			return f.GetPeriodEnd().AsTime(), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func parseContribution(
+	userID string,
+	goalID string,
+	transactionID string,
+) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	gid, err := uuid.Parse(goalID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, fmt.Errorf("invalid goal ID: %w", err)
+	}
+
+	tid, err := uuid.Parse(transactionID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	return uid, gid, tid, nil
+}
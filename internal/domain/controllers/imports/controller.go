@@ -0,0 +1,440 @@
+package imports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+	walletpb "backend-master/internal/api-gen/proto/wallet"
+	"backend-master/internal/data/repositories/imports"
+	"backend-master/internal/data/repositories/wallet"
+	walletctrl "backend-master/internal/domain/controllers/wallet"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrImportAccount   = errors.New("import account not found")
+	ErrProfileRequired = errors.New("CSV import requires a saved profile or a column mapping")
+	ErrTransferAccount = errors.New("transfer account not found")
+)
+
+const msgDuplicate = "duplicate of an existing transaction"
+
+type ImportController interface {
+	ListProfiles(
+		ctx context.Context,
+		userID string,
+	) ([]*masterpb.ImportProfile, error)
+
+	CreateProfile(
+		ctx context.Context,
+		userID string,
+		profile *masterpb.ImportProfile,
+	) (*masterpb.ImportProfile, error)
+
+	UpdateProfile(
+		ctx context.Context,
+		userID string,
+		profileID string,
+		profile *masterpb.ImportProfile,
+	) (*masterpb.ImportProfile, error)
+
+	DeleteProfile(
+		ctx context.Context,
+		userID string,
+		profileID string,
+	) error
+
+	// ImportTransactions parses a CSV, OFX/QFX or QIF statement and creates
+	// a transaction in the account for every row that is not already there.
+	// Rows the statement marks as transfers become transfers with
+	// transferAccountID when it is set. With dryRun nothing is created.
+	ImportTransactions(
+		ctx context.Context,
+		userID string,
+		accountID string,
+		transferAccountID string,
+		format masterpb.ImportFormat,
+		profileID string,
+		profile *masterpb.ImportProfile,
+		content []byte,
+		dryRun bool,
+	) (*ImportResult, error)
+}
+
+type ImportResult struct {
+	Rows     []*masterpb.ImportRowResult
+	Accepted int32
+	Skipped  int32
+	Errors   int32
+}
+
+type importControllerImpl struct {
+	repo       imports.ImportRepository
+	walletRepo wallet.WalletRepository
+	walletCtrl walletctrl.WalletController
+	logger     *zap.Logger
+}
+
+func NewController(
+	repo imports.ImportRepository,
+	walletRepo wallet.WalletRepository,
+	walletCtrl walletctrl.WalletController,
+	logger *zap.Logger,
+) ImportController {
+	return &importControllerImpl{
+		repo:       repo,
+		walletRepo: walletRepo,
+		walletCtrl: walletCtrl,
+		logger:     logger,
+	}
+}
+
+func (cont *importControllerImpl) ListProfiles(
+	ctx context.Context,
+	userID string,
+) ([]*masterpb.ImportProfile, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	profiles, err := cont.repo.GetProfiles(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import profiles from repository: %w", err)
+	}
+
+	pbProfiles := make([]*masterpb.ImportProfile, 0, len(profiles))
+	for _, p := range profiles {
+		pbProfiles = append(pbProfiles, p.ToProto())
+	}
+
+	return pbProfiles, nil
+}
+
+func (cont *importControllerImpl) CreateProfile(
+	ctx context.Context,
+	userID string,
+	pbProfile *masterpb.ImportProfile,
+) (*masterpb.ImportProfile, error) {
+	p, err := newNamedProfile(userID, pbProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := cont.repo.CreateProfile(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import profile in repository: %w", err)
+	}
+
+	return created.ToProto(), nil
+}
+
+func (cont *importControllerImpl) UpdateProfile(
+	ctx context.Context,
+	userID string,
+	profileID string,
+	pbProfile *masterpb.ImportProfile,
+) (*masterpb.ImportProfile, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
+	}
+
+	p, err := newNamedProfile(userID, pbProfile)
+	if err != nil {
+		return nil, err
+	}
+	p.ID = pid
+
+	updated, err := cont.repo.UpdateProfile(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update import profile in repository: %w", err)
+	}
+
+	return updated.ToProto(), nil
+}
+
+func (cont *importControllerImpl) DeleteProfile(
+	ctx context.Context,
+	userID string,
+	profileID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return fmt.Errorf("invalid profile ID: %w", err)
+	}
+
+	if err := cont.repo.DeleteProfile(ctx, uid, pid); err != nil {
+		return fmt.Errorf("failed to delete import profile in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (cont *importControllerImpl) ImportTransactions(
+	ctx context.Context,
+	userID string,
+	accountID string,
+	transferAccountID string,
+	format masterpb.ImportFormat,
+	profileID string,
+	pbProfile *masterpb.ImportProfile,
+	content []byte,
+	dryRun bool,
+) (*ImportResult, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	aid, err := uuid.Parse(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	account, err := cont.account(ctx, uid, aid)
+	if err != nil {
+		return nil, err
+	}
+
+	var transferAccount uuid.NullUUID
+	if transferAccountID != "" {
+		tid, err := uuid.Parse(transferAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transfer account ID: %w", err)
+		}
+		if tid == aid {
+			return nil, walletctrl.ErrTransferToSameAccount
+		}
+		if _, err := cont.account(ctx, uid, tid); err != nil {
+			if errors.Is(err, ErrImportAccount) {
+				err = ErrTransferAccount
+			}
+			return nil, err
+		}
+
+		transferAccount = uuid.NullUUID{UUID: tid, Valid: true}
+	}
+
+	rows, err := cont.parseStatement(ctx, uid, format, profileID, pbProfile, content, account.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		rows[i].accountID = aid
+		if rows[i].err != nil {
+			continue
+		}
+
+		rows[i].tx.Fingerprint = fingerprint(rows[i].tx.Date, rows[i].signed, rows[i].tx.Description)
+		if rows[i].transfer && transferAccount.Valid {
+			rows[i].asTransfer(transferAccount.UUID)
+		}
+	}
+
+	existing, err := cont.existingTransactions(ctx, uid, aid, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{
+		Rows: make([]*masterpb.ImportRowResult, 0, len(rows)),
+	}
+	for _, row := range rows {
+		rowResult := &masterpb.ImportRowResult{
+			Row: int32(row.line),
+		}
+
+		switch {
+		case row.err != nil:
+			rowResult.Status = masterpb.ImportRowStatus_IMPORT_ROW_STATUS_ERROR
+			rowResult.Message = row.err.Error()
+
+		case existing.match(row.tx):
+			rowResult.Status = masterpb.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED
+			rowResult.Message = msgDuplicate
+			rowResult.Transaction = row.preview()
+
+		case dryRun:
+			existing.add(row.tx)
+			rowResult.Status = masterpb.ImportRowStatus_IMPORT_ROW_STATUS_ACCEPTED
+			rowResult.Transaction = row.preview()
+
+		default:
+			created, err := cont.walletCtrl.ImportTransaction(ctx, userID, row.accountID.String(), row.tx)
+			switch {
+			case errors.Is(err, wallet.ErrTransactionExists):
+				rowResult.Status = masterpb.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED
+				rowResult.Message = msgDuplicate
+				rowResult.Transaction = row.preview()
+
+			case err != nil:
+				cont.logger.Warn(
+					"failed to import statement row",
+					zap.String("account_id", accountID),
+					zap.Int("line", row.line),
+					zap.Error(err),
+				)
+				rowResult.Status = masterpb.ImportRowStatus_IMPORT_ROW_STATUS_ERROR
+				rowResult.Message = err.Error()
+
+			default:
+				existing.add(row.tx)
+				rowResult.Status = masterpb.ImportRowStatus_IMPORT_ROW_STATUS_ACCEPTED
+				rowResult.Transaction = created
+			}
+		}
+
+		switch rowResult.Status {
+		case masterpb.ImportRowStatus_IMPORT_ROW_STATUS_ACCEPTED:
+			result.Accepted++
+		case masterpb.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED:
+			result.Skipped++
+		default:
+			result.Errors++
+		}
+		result.Rows = append(result.Rows, rowResult)
+	}
+
+	return result, nil
+}
+
+// parseStatement reads the statement in the given format. CSV needs a
+// column mapping from a saved profile or the request; OFX and QIF only take
+// its encoding, date format and decimal separator when one is given.
+func (cont *importControllerImpl) parseStatement(
+	ctx context.Context,
+	userID uuid.UUID,
+	format masterpb.ImportFormat,
+	profileID string,
+	pbProfile *masterpb.ImportProfile,
+	content []byte,
+	currency string,
+) ([]statementRow, error) {
+	switch format {
+	case masterpb.ImportFormat_IMPORT_FORMAT_OFX, masterpb.ImportFormat_IMPORT_FORMAT_QIF:
+		var opts formatOptions
+		if profileID == "" {
+			var err error
+			if opts, err = newFormatOptions(pbProfile); err != nil {
+				return nil, err
+			}
+		} else {
+			p, err := cont.savedProfile(ctx, userID, profileID)
+			if err != nil {
+				return nil, err
+			}
+			opts = profileFormatOptions(p)
+		}
+
+		if format == masterpb.ImportFormat_IMPORT_FORMAT_OFX {
+			return parseOFX(content, opts, currency)
+		}
+		return parseQIF(content, opts, currency)
+
+	default:
+		if profileID != "" {
+			p, err := cont.savedProfile(ctx, userID, profileID)
+			if err != nil {
+				return nil, err
+			}
+			return parseCSV(p, content, currency)
+		}
+
+		if pbProfile == nil {
+			return nil, ErrProfileRequired
+		}
+		p, err := newProfile(userID, pbProfile)
+		if err != nil {
+			return nil, err
+		}
+		return parseCSV(p, content, currency)
+	}
+}
+
+func (cont *importControllerImpl) savedProfile(
+	ctx context.Context,
+	userID uuid.UUID,
+	profileID string,
+) (*imports.Profile, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile ID: %w", err)
+	}
+
+	p, err := cont.repo.GetProfile(ctx, userID, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import profile from repository: %w", err)
+	}
+
+	return p, nil
+}
+
+func (cont *importControllerImpl) account(
+	ctx context.Context,
+	userID uuid.UUID,
+	accountID uuid.UUID,
+) (*wallet.Account, error) {
+	accounts, err := cont.walletRepo.GetAccountsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts from repository: %w", err)
+	}
+
+	for i := range accounts {
+		if accounts[i].ID != accountID {
+			continue
+		}
+		if accounts[i].ArchivedAt.Valid {
+			return nil, walletctrl.ErrAccountArchived
+		}
+		return &accounts[i], nil
+	}
+
+	return nil, ErrImportAccount
+}
+
+func newNamedProfile(userID string, pbProfile *masterpb.ImportProfile) (*imports.Profile, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	p, err := newProfile(uid, pbProfile)
+	if err != nil {
+		return nil, err
+	}
+	if p.Name == "" {
+		return nil, ErrEmptyProfileName
+	}
+
+	return p, nil
+}
+
+// preview shows a statement row as the transaction it would become.
+func (row *statementRow) preview() *walletpb.Transaction {
+	return &walletpb.Transaction{
+		AccountId:     row.accountID.String(),
+		FromAccountId: row.tx.FromAccountID,
+		ToAccountId:   row.tx.ToAccountID,
+		Type:          row.tx.Type,
+		Amount: &common.Money{
+			Amount:   row.tx.Amount,
+			Currency: row.tx.Currency,
+		},
+		Category:    row.tx.CategoryID,
+		Date:        timestamppb.New(row.tx.Date),
+		Description: row.tx.Description,
+	}
+}
@@ -0,0 +1,273 @@
+package imports
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend-master/internal/data/repositories/imports"
+	walletctrl "backend-master/internal/domain/controllers/wallet"
+)
+
+var (
+	ErrUnknownColumn    = errors.New("column not found in header")
+	ErrDebitAndCredit   = errors.New("row has both a debit and a credit amount")
+	ErrInvalidMCC       = errors.New("invalid MCC")
+	ErrMalformedCSVLine = errors.New("malformed CSV line")
+)
+
+// mapping holds the 0-based positions of mapped columns, -1 when unmapped.
+type mapping struct {
+	date        int
+	amount      int
+	debit       int
+	credit      int
+	description int
+	mcc         int
+	currency    int
+}
+
+// parseCSV reads a CSV statement laid out as the profile describes. Rows
+// without a currency column get the account currency.
+func parseCSV(
+	p *imports.Profile,
+	content []byte,
+	currency string,
+) ([]statementRow, error) {
+	decoded, err := decode(content, p.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	r := csv.NewReader(decoded)
+	r.Comma, _ = utf8.DecodeRuneInString(p.Delimiter)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	for range p.SkipRows {
+		if _, err := r.Read(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, nil
+			}
+			if !isParseError(err) {
+				return nil, fmt.Errorf("failed to read statement: %w", err)
+			}
+		}
+	}
+
+	var header map[string]int
+	if p.HasHeader {
+		record, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to read statement header: %w", err)
+		}
+
+		header = make(map[string]int, len(record))
+		for i, name := range record {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if _, ok := header[name]; !ok {
+				header[name] = i
+			}
+		}
+	}
+
+	m, err := newMapping(p, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []statementRow
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if len(rows) == maxImportRows {
+			return nil, ErrTooManyRows
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("failed to read statement: %w", err)
+			}
+			rows = append(rows, statementRow{
+				line: parseErr.StartLine,
+				err:  fmt.Errorf("%w: %w", ErrMalformedCSVLine, parseErr.Err),
+			})
+			continue
+		}
+
+		if isBlank(record) {
+			continue
+		}
+
+		line, _ := r.FieldPos(0)
+		row := statementRow{line: line}
+		row.tx, row.signed, row.err = m.parse(p, record, currency)
+		if row.err == nil {
+			row.fillType()
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func newMapping(p *imports.Profile, header map[string]int) (*mapping, error) {
+	resolve := func(name string, valid bool) (int, error) {
+		if !valid {
+			return -1, nil
+		}
+		if n := columnNumber(name); n > 0 {
+			return n - 1, nil
+		}
+		if i, ok := header[strings.ToLower(name)]; ok {
+			return i, nil
+		}
+		return -1, fmt.Errorf("%w: %q", ErrUnknownColumn, name)
+	}
+
+	var (
+		m   mapping
+		err error
+	)
+
+	if m.date, err = resolve(p.DateColumn, true); err != nil {
+		return nil, err
+	}
+	if m.amount, err = resolve(p.AmountColumn.String, p.AmountColumn.Valid); err != nil {
+		return nil, err
+	}
+	if m.debit, err = resolve(p.DebitColumn.String, p.DebitColumn.Valid); err != nil {
+		return nil, err
+	}
+	if m.credit, err = resolve(p.CreditColumn.String, p.CreditColumn.Valid); err != nil {
+		return nil, err
+	}
+	if m.description, err = resolve(p.DescriptionColumn.String, p.DescriptionColumn.Valid); err != nil {
+		return nil, err
+	}
+	if m.mcc, err = resolve(p.MCCColumn.String, p.MCCColumn.Valid); err != nil {
+		return nil, err
+	}
+	if m.currency, err = resolve(p.CurrencyColumn.String, p.CurrencyColumn.Valid); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// parse turns a record into a transaction and its signed amount. The type
+// and unsigned amount are left to the caller.
+func (m *mapping) parse(
+	p *imports.Profile,
+	record []string,
+	currency string,
+) (walletctrl.ImportedTransaction, int64, error) {
+	var tx walletctrl.ImportedTransaction
+
+	date := field(record, m.date)
+	if date == "" {
+		return tx, 0, fmt.Errorf("%w: date", ErrMissingValue)
+	}
+	parsed, err := time.ParseInLocation(dateLayout.Replace(p.DateFormat), date, time.UTC)
+	if err != nil {
+		return tx, 0, fmt.Errorf("%w %q", ErrInvalidDate, date)
+	}
+
+	var signed int64
+	if p.SignConvention == "DEBIT_CREDIT" {
+		debit, err := optionalAmount(field(record, m.debit), p.DecimalSeparator)
+		if err != nil {
+			return tx, 0, err
+		}
+		credit, err := optionalAmount(field(record, m.credit), p.DecimalSeparator)
+		if err != nil {
+			return tx, 0, err
+		}
+
+		switch {
+		case debit != 0 && credit != 0:
+			return tx, 0, ErrDebitAndCredit
+		case debit != 0:
+			signed = -abs(debit)
+		default:
+			signed = abs(credit)
+		}
+	} else {
+		amount := field(record, m.amount)
+		if amount == "" {
+			return tx, 0, fmt.Errorf("%w: amount", ErrMissingValue)
+		}
+		signed, err = parseAmount(amount, p.DecimalSeparator)
+		if err != nil {
+			return tx, 0, err
+		}
+		if p.SignConvention == "POSITIVE_EXPENSE" {
+			signed = -signed
+		}
+	}
+
+	if signed == 0 {
+		return tx, 0, ErrZeroAmount
+	}
+
+	tx.Date = parsed
+	tx.Description = field(record, m.description)
+	tx.Currency = currency
+
+	if mcc := field(record, m.mcc); mcc != "" {
+		n, err := strconv.Atoi(mcc)
+		if err != nil || n < 0 || n > 9999 {
+			return tx, 0, fmt.Errorf("%w %q", ErrInvalidMCC, mcc)
+		}
+		tx.CategoryID = strconv.Itoa(n)
+	}
+
+	if cur := field(record, m.currency); cur != "" {
+		if tx.Currency, err = parseCurrency(cur); err != nil {
+			return tx, 0, err
+		}
+	}
+
+	return tx, signed, nil
+}
+
+// optionalAmount parses a debit or credit cell where blank means zero.
+func optionalAmount(s string, decimalSeparator string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return parseAmount(s, decimalSeparator)
+}
+
+func field(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+func isBlank(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func isParseError(err error) bool {
+	var parseErr *csv.ParseError
+	return errors.As(err, &parseErr)
+}
@@ -0,0 +1,270 @@
+package imports
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"backend-master/internal/api-gen/proto/common"
+	"backend-master/internal/data/repositories/imports"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name             string
+		s                string
+		decimalSeparator string
+		want             int64
+		wantErr          error
+	}{
+		{name: "dot decimal", s: "12.34", decimalSeparator: ".", want: 1234},
+		{name: "comma decimal", s: "12,34", decimalSeparator: ",", want: 1234},
+		{name: "one decimal place", s: "12.5", decimalSeparator: ".", want: 1250},
+		{name: "whole", s: "12", decimalSeparator: ".", want: 1200},
+		{name: "comma thousands", s: "1,234.56", decimalSeparator: ".", want: 123456},
+		{name: "dot thousands", s: "1.234,56", decimalSeparator: ",", want: 123456},
+		{name: "millions", s: "1,234,567.89", decimalSeparator: ".", want: 123456789},
+		{name: "space thousands", s: "1 234,56", decimalSeparator: ",", want: 123456},
+		{name: "no-break space thousands", s: "1\u00a0234,56", decimalSeparator: ",", want: 123456},
+		{name: "apostrophe thousands", s: "1'234.56", decimalSeparator: ".", want: 123456},
+		{name: "trailing zeros", s: "12.340", decimalSeparator: ".", want: 1234},
+		{name: "leading minus", s: "-12.34", decimalSeparator: ".", want: -1234},
+		{name: "leading plus", s: "+12.34", decimalSeparator: ".", want: 1234},
+		{name: "trailing minus", s: "12.34-", decimalSeparator: ".", want: -1234},
+		{name: "parentheses", s: "(12.34)", decimalSeparator: ".", want: -1234},
+		{name: "minus in parentheses", s: "(-12.34)", decimalSeparator: ".", wantErr: ErrInvalidAmount},
+		{name: "fraction only", s: ".5", decimalSeparator: ".", want: 50},
+		{name: "sub-cent", s: "12.345", decimalSeparator: ".", wantErr: ErrInvalidAmount},
+		{name: "misplaced thousands separator", s: "12,34", decimalSeparator: ".", wantErr: ErrInvalidAmount},
+		{name: "letters", s: "12a", decimalSeparator: ".", wantErr: ErrInvalidAmount},
+		{name: "empty", s: "", decimalSeparator: ".", wantErr: ErrInvalidAmount},
+		{name: "sign only", s: "-", decimalSeparator: ".", wantErr: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAmount(tt.s, tt.decimalSeparator)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("parseAmount(%q) error = %v, want %v", tt.s, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseAmount(%q) error = %v", tt.s, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseAmount(%q) = %d, want %d", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	column := func(name string) sql.NullString {
+		return sql.NullString{String: name, Valid: true}
+	}
+	profile := func(edit func(p *imports.Profile)) *imports.Profile {
+		p := &imports.Profile{
+			Delimiter:         ",",
+			Encoding:          "utf-8",
+			DateFormat:        "YYYY-MM-DD",
+			DecimalSeparator:  ".",
+			HasHeader:         true,
+			SignConvention:    "NEGATIVE_EXPENSE",
+			DateColumn:        "date",
+			AmountColumn:      column("amount"),
+			DescriptionColumn: column("description"),
+		}
+		if edit != nil {
+			edit(p)
+		}
+		return p
+	}
+	cp1251 := func(s string) string {
+		encoded, err := charmap.Windows1251.NewEncoder().String(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return encoded
+	}
+
+	type row struct {
+		txType      common.TransactionType
+		amount      int64
+		description string
+	}
+
+	tests := []struct {
+		name    string
+		profile *imports.Profile
+		content string
+		want    []row
+	}{
+		{
+			name:    "negative expense",
+			profile: profile(nil),
+			content: "date,amount,description\n" +
+				"2024-01-02,-12.34,Coffee\n" +
+				"2024-01-03,1000,Salary\n",
+			want: []row{
+				{common.TransactionType_TRANSACTION_TYPE_EXPENSE, 1234, "Coffee"},
+				{common.TransactionType_TRANSACTION_TYPE_INCOME, 100000, "Salary"},
+			},
+		},
+		{
+			name: "positive expense",
+			profile: profile(func(p *imports.Profile) {
+				p.SignConvention = "POSITIVE_EXPENSE"
+			}),
+			content: "date,amount,description\n" +
+				"2024-01-02,12.34,Coffee\n" +
+				"2024-01-03,-1000,Refund\n",
+			want: []row{
+				{common.TransactionType_TRANSACTION_TYPE_EXPENSE, 1234, "Coffee"},
+				{common.TransactionType_TRANSACTION_TYPE_INCOME, 100000, "Refund"},
+			},
+		},
+		{
+			name: "debit and credit columns",
+			profile: profile(func(p *imports.Profile) {
+				p.SignConvention = "DEBIT_CREDIT"
+				p.AmountColumn = sql.NullString{}
+				p.DebitColumn = column("out")
+				p.CreditColumn = column("in")
+			}),
+			content: "date,out,in,description\n" +
+				"2024-01-02,12.34,,Coffee\n" +
+				"2024-01-03,,1000,Salary\n",
+			want: []row{
+				{common.TransactionType_TRANSACTION_TYPE_EXPENSE, 1234, "Coffee"},
+				{common.TransactionType_TRANSACTION_TYPE_INCOME, 100000, "Salary"},
+			},
+		},
+		{
+			name: "comma decimals and semicolons",
+			profile: profile(func(p *imports.Profile) {
+				p.Delimiter = ";"
+				p.DecimalSeparator = ","
+				p.DateFormat = "DD.MM.YYYY"
+			}),
+			content: "date;amount;description\n" +
+				"02.01.2024;-1.234,56;Rent\n",
+			want: []row{
+				{common.TransactionType_TRANSACTION_TYPE_EXPENSE, 123456, "Rent"},
+			},
+		},
+		{
+			name:    "utf-8 with byte order mark",
+			profile: profile(nil),
+			content: "\xEF\xBB\xBFdate,amount,description\n" +
+				"2024-01-02,-5,Кофе\n",
+			want: []row{
+				{common.TransactionType_TRANSACTION_TYPE_EXPENSE, 500, "Кофе"},
+			},
+		},
+		{
+			name: "windows-1251",
+			profile: profile(func(p *imports.Profile) {
+				p.Encoding = "windows-1251"
+			}),
+			content: cp1251("date,amount,description\n" +
+				"2024-01-02,-5,Продукты\n"),
+			want: []row{
+				{common.TransactionType_TRANSACTION_TYPE_EXPENSE, 500, "Продукты"},
+			},
+		},
+		{
+			name: "column numbers without header",
+			profile: profile(func(p *imports.Profile) {
+				p.HasHeader = false
+				p.DateColumn = "2"
+				p.AmountColumn = column("1")
+				p.DescriptionColumn = sql.NullString{}
+			}),
+			content: "-7.00,2024-01-02\n\n",
+			want: []row{
+				{common.TransactionType_TRANSACTION_TYPE_EXPENSE, 700, ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseCSV(tt.profile, []byte(tt.content), "RUB")
+			if err != nil {
+				t.Fatalf("parseCSV() error = %v", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("parseCSV() returned %d rows, want %d", len(rows), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				got := rows[i]
+				if got.err != nil {
+					t.Fatalf("row %d error = %v", i, got.err)
+				}
+				if got.tx.Type != want.txType || got.tx.Amount != want.amount {
+					t.Errorf(
+						"row %d = %s %d, want %s %d",
+						i,
+						got.tx.Type,
+						got.tx.Amount,
+						want.txType,
+						want.amount,
+					)
+				}
+				if got.tx.Description != want.description {
+					t.Errorf("row %d description = %q, want %q", i, got.tx.Description, want.description)
+				}
+				if got.tx.Currency != "RUB" {
+					t.Errorf("row %d currency = %q, want RUB", i, got.tx.Currency)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCSVRowErrors(t *testing.T) {
+	p := &imports.Profile{
+		Delimiter:        ",",
+		Encoding:         "utf-8",
+		DateFormat:       "YYYY-MM-DD",
+		DecimalSeparator: ".",
+		HasHeader:        true,
+		SignConvention:   "DEBIT_CREDIT",
+		DateColumn:       "date",
+		DebitColumn:      sql.NullString{String: "out", Valid: true},
+		CreditColumn:     sql.NullString{String: "in", Valid: true},
+	}
+	content := "date,out,in\n" +
+		"2024-01-02,1,2\n" +
+		"02/01/2024,1,\n" +
+		"2024-01-02,,\n" +
+		"2024-01-02,1.234,\n"
+
+	rows, err := parseCSV(p, []byte(content), "RUB")
+	if err != nil {
+		t.Fatalf("parseCSV() error = %v", err)
+	}
+
+	want := []struct {
+		line int
+		err  error
+	}{
+		{line: 2, err: ErrDebitAndCredit},
+		{line: 3, err: ErrInvalidDate},
+		{line: 4, err: ErrZeroAmount},
+		{line: 5, err: ErrInvalidAmount},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("parseCSV() returned %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		if rows[i].line != w.line || !errors.Is(rows[i].err, w.err) {
+			t.Errorf("row %d = line %d, error %v, want line %d, error %v", i, rows[i].line, rows[i].err, w.line, w.err)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package imports
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-master/internal/data/database"
+	"backend-master/internal/data/repositories/wallet"
+	walletctrl "backend-master/internal/domain/controllers/wallet"
+
+	"github.com/google/uuid"
+)
+
+// scanBatchSize is how many existing transactions are loaded at once when
+// looking for duplicates
+const scanBatchSize = 500
+
+// fingerprint identifies a statement row by its day, signed amount and
+// description, ignoring case and repeated spaces.
+func fingerprint(date time.Time, signed int64, description string) string {
+	description = strings.Join(strings.Fields(strings.ToLower(description)), " ")
+
+	sum := sha256.Sum256([]byte(
+		date.UTC().Format(time.DateOnly) + "|" + strconv.FormatInt(signed, 10) + "|" + description,
+	))
+	return hex.EncodeToString(sum[:])
+}
+
+// existingSet holds what is known about transactions already in the
+// account: fingerprints counted by how many transactions share them and the
+// external IDs of imported ones.
+type existingSet struct {
+	fingerprints map[string]int
+	externalIDs  map[string]bool
+}
+
+// match reports whether the row is already in the account. Every existing
+// transaction is matched by one row at most, so identical rows in a
+// statement are only skipped as many times as they were imported before.
+func (e *existingSet) match(tx walletctrl.ImportedTransaction) bool {
+	switch {
+	case tx.ExternalID != "" && e.externalIDs[tx.ExternalID]:
+		if e.fingerprints[tx.Fingerprint] > 0 {
+			e.fingerprints[tx.Fingerprint]--
+		}
+		return true
+
+	case e.fingerprints[tx.Fingerprint] > 0:
+		e.fingerprints[tx.Fingerprint]--
+		return true
+
+	default:
+		return false
+	}
+}
+
+// add records an accepted row so a repeated external ID in the same
+// statement is skipped.
+func (e *existingSet) add(tx walletctrl.ImportedTransaction) {
+	if tx.ExternalID != "" {
+		e.externalIDs[tx.ExternalID] = true
+	}
+}
+
+// existingTransactions loads the account's transactions made on the days
+// the statement covers, including its legs of transfers. Imported
+// transactions keep the fingerprint of their statement row, so later renames
+// by rules or by hand do not hide them; other transactions are fingerprinted
+// as they are now.
+func (cont *importControllerImpl) existingTransactions(
+	ctx context.Context,
+	userID uuid.UUID,
+	accountID uuid.UUID,
+	rows []statementRow,
+) (*existingSet, error) {
+	var first, last time.Time
+	for _, row := range rows {
+		if row.err != nil {
+			continue
+		}
+		if first.IsZero() || row.tx.Date.Before(first) {
+			first = row.tx.Date
+		}
+		if last.IsZero() || row.tx.Date.After(last) {
+			last = row.tx.Date
+		}
+	}
+
+	existing := &existingSet{
+		fingerprints: make(map[string]int),
+		externalIDs:  make(map[string]bool),
+	}
+	if first.IsZero() {
+		return existing, nil
+	}
+
+	filter := wallet.TransactionFilter{
+		UserID:     userID,
+		AccountIDs: []uuid.UUID{accountID},
+		StartDate:  first.UTC().Truncate(24 * time.Hour),
+		EndDate:    last.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1),
+		Limit:      scanBatchSize,
+	}
+
+	for {
+		transactions, err := cont.walletRepo.GetTransactions(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transactions from repository: %w", err)
+		}
+
+		for _, tx := range transactions {
+			var signed int64
+			switch {
+			case tx.AccountID != accountID:
+				continue
+			case tx.Type == "EXPENSE", tx.IsOutgoingTransfer():
+				signed = -tx.Amount
+			default:
+				signed = tx.Amount
+			}
+
+			if tx.ExternalID.Valid {
+				existing.externalIDs[tx.ExternalID.String] = true
+			}
+			if tx.ImportFingerprint.Valid {
+				existing.fingerprints[tx.ImportFingerprint.String]++
+			} else {
+				existing.fingerprints[fingerprint(tx.CreatedAt, signed, tx.Description.String)]++
+			}
+		}
+
+		if len(transactions) < scanBatchSize {
+			return existing, nil
+		}
+
+		oldest := transactions[len(transactions)-1]
+		filter.After = &database.Cursor{
+			CreatedAt: oldest.CreatedAt,
+			ID:        oldest.ID,
+		}
+	}
+}
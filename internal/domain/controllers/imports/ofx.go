@@ -0,0 +1,263 @@
+package imports
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// OFX transaction types that always move money into or out of the account.
+// Banks do not always sign TRNAMT, so these correct the sign; other types
+// keep it.
+var (
+	ofxCreditTypes = map[string]bool{
+		"CREDIT":    true,
+		"DEP":       true,
+		"INT":       true,
+		"DIV":       true,
+		"DIRECTDEP": true,
+	}
+	ofxDebitTypes = map[string]bool{
+		"DEBIT":       true,
+		"FEE":         true,
+		"SRVCHG":      true,
+		"ATM":         true,
+		"POS":         true,
+		"CHECK":       true,
+		"PAYMENT":     true,
+		"CASH":        true,
+		"DIRECTDEBIT": true,
+		"REPEATPMT":   true,
+	}
+)
+
+var (
+	ofxXMLEncoding = regexp.MustCompile(`(?i)<\?xml[^>]*encoding\s*=\s*["']([^"']+)["']`)
+	ofxHeaderField = regexp.MustCompile(`(?im)^\s*(ENCODING|CHARSET)\s*:\s*(\S+)`)
+)
+
+// parseOFX reads the transactions of an OFX or QFX statement, both the
+// SGML based 1.x and the XML based 2.x versions. Every statement in the file
+// is read into the account the import targets.
+func parseOFX(
+	content []byte,
+	opts formatOptions,
+	currency string,
+) ([]statementRow, error) {
+	encoding := opts.encoding
+	if encoding == "" {
+		encoding = ofxEncoding(content)
+	}
+
+	decoded, err := decode(content, encoding)
+	if err != nil {
+		return nil, err
+	}
+	text, err := io.ReadAll(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read statement: %w", err)
+	}
+
+	var (
+		rows     []statementRow
+		fields   map[string]string
+		statCurr = currency
+	)
+
+	// OFX 1.x leaves elements unclosed, so every tag is read up to the next
+	// one; only aggregates such as STMTTRN are closed in both versions
+	for rest := string(text); ; {
+		start := strings.IndexByte(rest, '<')
+		if start < 0 {
+			break
+		}
+		rest = rest[start+1:]
+
+		end := strings.IndexByte(rest, '>')
+		if end < 0 {
+			break
+		}
+		tag := strings.ToUpper(strings.TrimSpace(rest[:end]))
+		rest = rest[end+1:]
+
+		value := rest
+		if next := strings.IndexByte(rest, '<'); next >= 0 {
+			value = rest[:next]
+		}
+		value = html.UnescapeString(strings.TrimSpace(value))
+
+		switch {
+		case tag == "STMTTRN":
+			fields = make(map[string]string)
+
+		case tag == "/STMTTRN":
+			if fields == nil {
+				continue
+			}
+			if len(rows) == maxImportRows {
+				return nil, ErrTooManyRows
+			}
+			rows = append(rows, ofxRow(len(rows)+1, fields, opts, statCurr))
+			fields = nil
+
+		case tag == "CURDEF":
+			if cur, err := parseCurrency(value); err == nil {
+				statCurr = cur
+			}
+
+		case fields != nil && value != "" && !strings.HasPrefix(tag, "/"):
+			fields[tag] = value
+		}
+	}
+
+	return rows, nil
+}
+
+func ofxRow(
+	n int,
+	fields map[string]string,
+	opts formatOptions,
+	currency string,
+) statementRow {
+	row := statementRow{line: n}
+
+	posted := fields["DTPOSTED"]
+	if posted == "" {
+		row.err = fmt.Errorf("%w: DTPOSTED", ErrMissingValue)
+		return row
+	}
+	date, err := parseOFXDate(posted)
+	if err != nil {
+		row.err = err
+		return row
+	}
+
+	amount := fields["TRNAMT"]
+	if amount == "" {
+		row.err = fmt.Errorf("%w: TRNAMT", ErrMissingValue)
+		return row
+	}
+	separator := opts.decimalSeparator
+	if separator == "" {
+		separator = "."
+		// the spec allows a comma in locales that use one
+		if strings.Contains(amount, ",") && !strings.Contains(amount, ".") {
+			separator = ","
+		}
+	}
+	row.signed, err = parseAmount(amount, separator)
+	if err != nil {
+		row.err = err
+		return row
+	}
+	if row.signed == 0 {
+		row.err = ErrZeroAmount
+		return row
+	}
+
+	trnType := fields["TRNTYPE"]
+	switch {
+	case ofxCreditTypes[trnType]:
+		row.signed = abs(row.signed)
+	case ofxDebitTypes[trnType]:
+		row.signed = -abs(row.signed)
+	case trnType == "XFER":
+		row.transfer = true
+	}
+
+	row.tx.Date = date
+	row.tx.Currency = currency
+	row.tx.Description = joinDescription(fields["NAME"], fields["MEMO"])
+	row.tx.ExternalID = fields["FITID"]
+	row.fillType()
+
+	return row
+}
+
+// parseOFXDate reads an OFX datetime, YYYYMMDD[HHMMSS[.XXX]][[offset:TZ]].
+// Dates without a time are taken as they are, whatever the offset.
+func parseOFXDate(s string) (time.Time, error) {
+	raw := s
+
+	loc := time.UTC
+	if i := strings.IndexByte(s, '['); i >= 0 {
+		zone := strings.TrimSuffix(s[i+1:], "]")
+		s = s[:i]
+
+		offset, name, _ := strings.Cut(zone, ":")
+		if hours, err := strconv.ParseFloat(offset, 64); err == nil {
+			loc = time.FixedZone(name, int(hours*3600))
+		}
+	}
+	s, _, _ = strings.Cut(strings.TrimSpace(s), ".")
+
+	var layout string
+	switch len(s) {
+	case 8:
+		layout = "20060102"
+		loc = time.UTC
+	case 12:
+		layout = "200601021504"
+	case 14:
+		layout = "20060102150405"
+	default:
+		return time.Time{}, fmt.Errorf("%w %q", ErrInvalidDate, raw)
+	}
+
+	date, err := time.ParseInLocation(layout, s, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w %q", ErrInvalidDate, raw)
+	}
+
+	return date, nil
+}
+
+// ofxEncoding reads the encoding from the XML declaration of OFX 2.x or the
+// ENCODING and CHARSET header fields of OFX 1.x, where CHARSET holds a
+// Windows code page number.
+func ofxEncoding(content []byte) string {
+	header := content
+	if i := bytes.Index(bytes.ToUpper(content), []byte("<OFX")); i >= 0 {
+		header = content[:i]
+	}
+
+	if m := ofxXMLEncoding.FindSubmatch(header); m != nil {
+		return knownEncoding(string(m[1]))
+	}
+
+	var encoding, charset string
+	for _, m := range ofxHeaderField.FindAllSubmatch(header, -1) {
+		if strings.EqualFold(string(m[1]), "ENCODING") {
+			encoding = string(m[2])
+		} else {
+			charset = string(m[2])
+		}
+	}
+
+	switch {
+	case strings.EqualFold(encoding, "UTF-8"):
+		return defaultEncoding
+	case charset == "" || strings.EqualFold(charset, "NONE"):
+		return defaultEncoding
+	case isDigits(charset):
+		return knownEncoding("windows-" + charset)
+	default:
+		return knownEncoding(charset)
+	}
+}
+
+// knownEncoding returns the encoding when it is supported and UTF-8
+// otherwise.
+func knownEncoding(name string) string {
+	if _, err := htmlindex.Get(name); err != nil {
+		return defaultEncoding
+	}
+	return strings.ToLower(name)
+}
@@ -0,0 +1,227 @@
+package imports
+
+import (
+	"testing"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+func TestParseOFX(t *testing.T) {
+	sgml := "OFXHEADER:100\n" +
+		"DATA:OFXSGML\n" +
+		"VERSION:102\n" +
+		"ENCODING:USASCII\n" +
+		"CHARSET:1252\n" +
+		"\n" +
+		"<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS>\n" +
+		"<CURDEF>USD\n" +
+		"<BANKTRANLIST>\n" +
+		"<STMTTRN>\n" +
+		"<TRNTYPE>POS\n" +
+		"<DTPOSTED>20240102\n" +
+		"<TRNAMT>12.34\n" +
+		"<FITID>A-1\n" +
+		"<NAME>Caf\xe9\n" +
+		"<MEMO>Card 1234\n" +
+		"</STMTTRN>\n" +
+		"<STMTTRN>\n" +
+		"<TRNTYPE>OTHER\n" +
+		"<DTPOSTED>20240103120000[-5:EST]\n" +
+		"<TRNAMT>1,000.00\n" +
+		"<FITID>A-2\n" +
+		"<NAME>Salary &amp; bonus\n" +
+		"</STMTTRN>\n" +
+		"<STMTTRN>\n" +
+		"<TRNTYPE>XFER\n" +
+		"<DTPOSTED>20240104\n" +
+		"<TRNAMT>-50\n" +
+		"<FITID>A-3\n" +
+		"</STMTTRN>\n" +
+		"</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>\n"
+
+	xml := `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>EUR</CURDEF>
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>CREDIT</TRNTYPE>
+<DTPOSTED>20240105</DTPOSTED>
+<TRNAMT>-7,50</TRNAMT>
+<FITID>B-1</FITID>
+<NAME>Refund</NAME>
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT</TRNTYPE>
+<DTPOSTED>20240106</DTPOSTED>
+<TRNAMT>0</TRNAMT>
+<FITID>B-2</FITID>
+</STMTTRN>
+</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>
+`
+
+	type row struct {
+		externalID  string
+		txType      common.TransactionType
+		amount      int64
+		currency    string
+		description string
+		date        time.Time
+		transfer    bool
+		err         error
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    []row
+	}{
+		{
+			name:    "sgml",
+			content: sgml,
+			want: []row{
+				{
+					externalID:  "A-1",
+					txType:      common.TransactionType_TRANSACTION_TYPE_EXPENSE,
+					amount:      1234,
+					currency:    "USD",
+					description: "Café Card 1234",
+					date:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					externalID:  "A-2",
+					txType:      common.TransactionType_TRANSACTION_TYPE_INCOME,
+					amount:      100000,
+					currency:    "USD",
+					description: "Salary & bonus",
+					date:        time.Date(2024, 1, 3, 17, 0, 0, 0, time.UTC),
+				},
+				{
+					externalID: "A-3",
+					txType:     common.TransactionType_TRANSACTION_TYPE_EXPENSE,
+					amount:     5000,
+					currency:   "USD",
+					date:       time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+					transfer:   true,
+				},
+			},
+		},
+		{
+			name:    "xml",
+			content: xml,
+			want: []row{
+				{
+					externalID:  "B-1",
+					txType:      common.TransactionType_TRANSACTION_TYPE_INCOME,
+					amount:      750,
+					currency:    "EUR",
+					description: "Refund",
+					date:        time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+				},
+				{err: ErrZeroAmount},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseOFX([]byte(tt.content), formatOptions{}, "RUB")
+			if err != nil {
+				t.Fatalf("parseOFX() error = %v", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("parseOFX() returned %d rows, want %d", len(rows), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				got := rows[i]
+				if got.line != i+1 {
+					t.Errorf("row %d line = %d, want %d", i, got.line, i+1)
+				}
+				if want.err != nil {
+					if got.err != want.err {
+						t.Errorf("row %d error = %v, want %v", i, got.err, want.err)
+					}
+					continue
+				}
+				if got.err != nil {
+					t.Fatalf("row %d error = %v", i, got.err)
+				}
+
+				if got.tx.ExternalID != want.externalID {
+					t.Errorf("row %d external ID = %q, want %q", i, got.tx.ExternalID, want.externalID)
+				}
+				if got.tx.Type != want.txType || got.tx.Amount != want.amount {
+					t.Errorf(
+						"row %d = %s %d, want %s %d",
+						i,
+						got.tx.Type,
+						got.tx.Amount,
+						want.txType,
+						want.amount,
+					)
+				}
+				if got.tx.Currency != want.currency {
+					t.Errorf("row %d currency = %q, want %q", i, got.tx.Currency, want.currency)
+				}
+				if got.tx.Description != want.description {
+					t.Errorf("row %d description = %q, want %q", i, got.tx.Description, want.description)
+				}
+				if !got.tx.Date.Equal(want.date) {
+					t.Errorf("row %d date = %s, want %s", i, got.tx.Date, want.date)
+				}
+				if got.transfer != want.transfer {
+					t.Errorf("row %d transfer = %t, want %t", i, got.transfer, want.transfer)
+				}
+			}
+		})
+	}
+}
+
+func TestOFXEncoding(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "xml declaration", header: `<?xml version="1.0" encoding="windows-1251"?>`, want: "windows-1251"},
+		{name: "utf-8 header", header: "ENCODING:UTF-8\nCHARSET:NONE\n", want: "utf-8"},
+		{name: "code page", header: "ENCODING:USASCII\nCHARSET:1251\n", want: "windows-1251"},
+		{name: "no charset", header: "ENCODING:USASCII\nCHARSET:NONE\n", want: "utf-8"},
+		{name: "unknown charset", header: "ENCODING:USASCII\nCHARSET:9999\n", want: "utf-8"},
+		{name: "no header", header: "", want: "utf-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ofxEncoding([]byte(tt.header + "\n<OFX></OFX>"))
+			if got != tt.want {
+				t.Errorf("ofxEncoding() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOFXCodePage(t *testing.T) {
+	name, err := charmap.Windows1251.NewEncoder().String("Магазин")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := "ENCODING:USASCII\nCHARSET:1251\n\n<OFX>" +
+		"<STMTTRN><TRNTYPE>POS<DTPOSTED>20240102<TRNAMT>-1<FITID>C-1<NAME>" + name + "</STMTTRN>" +
+		"</OFX>"
+
+	rows, err := parseOFX([]byte(content), formatOptions{}, "RUB")
+	if err != nil {
+		t.Fatalf("parseOFX() error = %v", err)
+	}
+	if len(rows) != 1 || rows[0].err != nil {
+		t.Fatalf("parseOFX() = %+v, want one valid row", rows)
+	}
+	if rows[0].tx.Description != "Магазин" {
+		t.Errorf("description = %q, want %q", rows[0].tx.Description, "Магазин")
+	}
+}
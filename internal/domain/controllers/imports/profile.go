@@ -0,0 +1,213 @@
+package imports
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	masterpb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/repositories/imports"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+const (
+	defaultDelimiter        = ","
+	defaultEncoding         = "utf-8"
+	defaultDecimalSeparator = "."
+)
+
+var (
+	ErrEmptyProfileName     = errors.New("import profile name must not be empty")
+	ErrInvalidDelimiter     = errors.New("delimiter must be a single character other than a quote or a line break")
+	ErrUnknownEncoding      = errors.New("unknown encoding")
+	ErrInvalidDateFormat    = errors.New("date format must contain DD, MM and YYYY or YY")
+	ErrInvalidDecimalSep    = errors.New("decimal separator must be '.' or ','")
+	ErrInvalidSkipRows      = errors.New("skip rows must not be negative")
+	ErrDateColumnRequired   = errors.New("date column is required")
+	ErrAmountColumnRequired = errors.New("amount column is required")
+	ErrDebitCreditRequired  = errors.New("debit and credit columns are required")
+	ErrColumnNeedsHeader    = errors.New("columns can only be named when the file has a header, otherwise use column numbers")
+)
+
+// dateLayout translates the date format tokens into a Go time layout.
+var dateLayout = strings.NewReplacer(
+	"YYYY", "2006",
+	"YY", "06",
+	"MM", "01",
+	"DD", "02",
+	"HH", "15",
+	"mm", "04",
+	"ss", "05",
+)
+
+// newProfile validates the column mapping and fills in defaults. The name is
+// only checked by the caller, because one-off mappings are never saved.
+func newProfile(userID uuid.UUID, pbProfile *masterpb.ImportProfile) (*imports.Profile, error) {
+	if pbProfile == nil {
+		return nil, ErrDateColumnRequired
+	}
+
+	p := &imports.Profile{
+		UserID:           userID,
+		Name:             strings.TrimSpace(pbProfile.Name),
+		Delimiter:        pbProfile.Delimiter,
+		Encoding:         strings.ToLower(strings.TrimSpace(pbProfile.Encoding)),
+		DateFormat:       strings.TrimSpace(pbProfile.DateFormat),
+		DecimalSeparator: pbProfile.DecimalSeparator,
+		SkipRows:         pbProfile.SkipRows,
+		HasHeader:        pbProfile.HasHeader == nil || *pbProfile.HasHeader,
+		SignConvention:   imports.SignConventionPbTypeToDbType(pbProfile.SignConvention),
+		DateColumn:       strings.TrimSpace(pbProfile.DateColumn),
+	}
+
+	if p.Delimiter == "" {
+		p.Delimiter = defaultDelimiter
+	}
+	if p.Encoding == "" {
+		p.Encoding = defaultEncoding
+	}
+	if p.DecimalSeparator == "" {
+		p.DecimalSeparator = defaultDecimalSeparator
+	}
+	if p.SignConvention == "" {
+		p.SignConvention = imports.SignConventionPbTypeToDbType(
+			masterpb.ImportSignConvention_IMPORT_SIGN_CONVENTION_NEGATIVE_EXPENSE,
+		)
+	}
+
+	p.AmountColumn = column(pbProfile.AmountColumn)
+	p.DebitColumn = column(pbProfile.DebitColumn)
+	p.CreditColumn = column(pbProfile.CreditColumn)
+	p.DescriptionColumn = column(pbProfile.DescriptionColumn)
+	p.MCCColumn = column(pbProfile.MccColumn)
+	p.CurrencyColumn = column(pbProfile.CurrencyColumn)
+
+	if err := validateProfile(p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func validateProfile(p *imports.Profile) error {
+	if r, size := utf8.DecodeRuneInString(p.Delimiter); size != len(p.Delimiter) ||
+		r == utf8.RuneError || r == '"' || r == '\r' || r == '\n' {
+		return ErrInvalidDelimiter
+	}
+
+	if _, err := htmlindex.Get(p.Encoding); err != nil {
+		return ErrUnknownEncoding
+	}
+
+	if !dateOrderKnown(p.DateFormat) {
+		return ErrInvalidDateFormat
+	}
+
+	if p.DecimalSeparator != "." && p.DecimalSeparator != "," {
+		return ErrInvalidDecimalSep
+	}
+
+	if p.SkipRows < 0 {
+		return ErrInvalidSkipRows
+	}
+
+	if p.DateColumn == "" {
+		return ErrDateColumnRequired
+	}
+
+	if p.SignConvention == "DEBIT_CREDIT" {
+		if !p.DebitColumn.Valid || !p.CreditColumn.Valid {
+			return ErrDebitCreditRequired
+		}
+	} else if !p.AmountColumn.Valid {
+		return ErrAmountColumnRequired
+	}
+
+	if !p.HasHeader {
+		columns := []sql.NullString{
+			{String: p.DateColumn, Valid: true},
+			p.AmountColumn,
+			p.DebitColumn,
+			p.CreditColumn,
+			p.DescriptionColumn,
+			p.MCCColumn,
+			p.CurrencyColumn,
+		}
+		for _, c := range columns {
+			if c.Valid && columnNumber(c.String) == 0 {
+				return ErrColumnNeedsHeader
+			}
+		}
+	}
+
+	return nil
+}
+
+func column(name string) sql.NullString {
+	name = strings.TrimSpace(name)
+	return sql.NullString{String: name, Valid: name != ""}
+}
+
+// columnNumber returns the 1-based column number the mapping holds or 0 if it
+// names a header.
+func columnNumber(name string) int {
+	n, err := strconv.Atoi(name)
+	if err != nil || n < 1 {
+		return 0
+	}
+	return n
+}
+
+// formatOptions are the parts of a profile that apply to OFX and QIF
+// statements, which name their own fields.
+type formatOptions struct {
+	encoding         string // detected or UTF-8 when empty
+	dateFormat       string // the format's own when empty
+	decimalSeparator string // '.' when empty
+}
+
+// newFormatOptions reads the options set in a one-off mapping; a mapping is
+// optional for OFX and QIF.
+func newFormatOptions(pbProfile *masterpb.ImportProfile) (formatOptions, error) {
+	var opts formatOptions
+	if pbProfile == nil {
+		return opts, nil
+	}
+
+	opts.encoding = strings.ToLower(strings.TrimSpace(pbProfile.Encoding))
+	if opts.encoding != "" {
+		if _, err := htmlindex.Get(opts.encoding); err != nil {
+			return opts, ErrUnknownEncoding
+		}
+	}
+
+	opts.dateFormat = strings.TrimSpace(pbProfile.DateFormat)
+	if opts.dateFormat != "" && !dateOrderKnown(opts.dateFormat) {
+		return opts, ErrInvalidDateFormat
+	}
+
+	opts.decimalSeparator = pbProfile.DecimalSeparator
+	if opts.decimalSeparator != "" && opts.decimalSeparator != "." && opts.decimalSeparator != "," {
+		return opts, ErrInvalidDecimalSep
+	}
+
+	return opts, nil
+}
+
+func profileFormatOptions(p *imports.Profile) formatOptions {
+	return formatOptions{
+		encoding:         p.Encoding,
+		dateFormat:       p.DateFormat,
+		decimalSeparator: p.DecimalSeparator,
+	}
+}
+
+func dateOrderKnown(format string) bool {
+	return strings.Contains(format, "DD") &&
+		strings.Contains(format, "MM") &&
+		strings.Contains(format, "YY")
+}
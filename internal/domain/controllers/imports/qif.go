@@ -0,0 +1,228 @@
+package imports
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultQIFDateFormat is the US order Quicken writes dates in
+const defaultQIFDateFormat = "MM/DD/YYYY"
+
+// qifAccountTypes are the QIF sections that hold account transactions.
+// Investment, category and class lists are skipped.
+var qifAccountTypes = map[string]bool{
+	"BANK":  true,
+	"CASH":  true,
+	"CCARD": true,
+	"OTH A": true,
+	"OTH L": true,
+}
+
+// qifRecord holds the fields of one QIF transaction by their code letter.
+type qifRecord struct {
+	line   int
+	fields map[byte]string
+}
+
+// parseQIF reads the transactions of a QIF file. QIF dates carry no order,
+// so they follow the date format of the options or Quicken's MM/DD/YYYY.
+func parseQIF(
+	content []byte,
+	opts formatOptions,
+	currency string,
+) ([]statementRow, error) {
+	encoding := opts.encoding
+	if encoding == "" {
+		encoding = defaultEncoding
+	}
+	decoded, err := decode(content, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rows     []statementRow
+		record   *qifRecord
+		inTxns   bool
+		lineNo   int
+		scanner  = bufio.NewScanner(decoded)
+		flushRow = func() error {
+			if record == nil {
+				return nil
+			}
+			if len(rows) == maxImportRows {
+				return ErrTooManyRows
+			}
+			rows = append(rows, qifRow(record, opts, currency))
+			record = nil
+			return nil
+		}
+	)
+
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if line[0] == '!' {
+			if err := flushRow(); err != nil {
+				return nil, err
+			}
+
+			header := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(header, "!TYPE:"):
+				inTxns = qifAccountTypes[strings.TrimSpace(header[len("!TYPE:"):])]
+			case strings.HasPrefix(header, "!OPTION"), strings.HasPrefix(header, "!CLEAR"):
+				// switches that do not start a new section
+			default:
+				inTxns = false
+			}
+			continue
+		}
+
+		if !inTxns {
+			continue
+		}
+
+		if line == "^" {
+			if err := flushRow(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if record == nil {
+			record = &qifRecord{
+				line:   lineNo,
+				fields: make(map[byte]string),
+			}
+		}
+		// split lines repeat their codes, only the first value is kept
+		if _, ok := record.fields[line[0]]; !ok {
+			record.fields[line[0]] = strings.TrimSpace(line[1:])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read statement: %w", err)
+	}
+	if err := flushRow(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func qifRow(
+	record *qifRecord,
+	opts formatOptions,
+	currency string,
+) statementRow {
+	row := statementRow{line: record.line}
+
+	date := record.fields['D']
+	if date == "" {
+		row.err = fmt.Errorf("%w: date", ErrMissingValue)
+		return row
+	}
+
+	dateFormat := opts.dateFormat
+	if dateFormat == "" {
+		dateFormat = defaultQIFDateFormat
+	}
+	parsed, err := parseQIFDate(date, dateFormat)
+	if err != nil {
+		row.err = err
+		return row
+	}
+
+	amount := record.fields['T']
+	if amount == "" {
+		amount = record.fields['U']
+	}
+	if amount == "" {
+		row.err = fmt.Errorf("%w: amount", ErrMissingValue)
+		return row
+	}
+	separator := opts.decimalSeparator
+	if separator == "" {
+		separator = defaultDecimalSeparator
+	}
+	row.signed, err = parseAmount(amount, separator)
+	if err != nil {
+		row.err = err
+		return row
+	}
+	if row.signed == 0 {
+		row.err = ErrZeroAmount
+		return row
+	}
+
+	// a category in brackets names the account of a transfer
+	row.transfer = strings.HasPrefix(record.fields['L'], "[")
+
+	row.tx.Date = parsed
+	row.tx.Currency = currency
+	row.tx.Description = joinDescription(record.fields['P'], record.fields['M'])
+	row.fillType()
+
+	return row
+}
+
+// parseQIFDate reads dates such as 01/15/2024, 1/15'24 or 15.01.2024 with
+// the day, month and year in the order of the date format. Quicken marks
+// years after 1999 with an apostrophe.
+func parseQIFDate(s string, format string) (time.Time, error) {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("%w %q", ErrInvalidDate, s)
+	}
+
+	order := []struct {
+		pos   int
+		token string
+	}{
+		{strings.Index(format, "DD"), "DD"},
+		{strings.Index(format, "MM"), "MM"},
+		{strings.Index(format, "YY"), "YY"},
+	}
+	values := make(map[string]int, len(order))
+	for _, o := range order {
+		rank := 0
+		for _, other := range order {
+			if other.pos < o.pos {
+				rank++
+			}
+		}
+
+		n, err := strconv.Atoi(parts[rank])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w %q", ErrInvalidDate, s)
+		}
+		values[o.token] = n
+	}
+
+	year := values["YY"]
+	switch {
+	case strings.Contains(s, "'") && year < 100:
+		year += 2000
+	case year < 69:
+		year += 2000
+	case year < 100:
+		year += 1900
+	}
+
+	date := time.Date(year, time.Month(values["MM"]), values["DD"], 0, 0, 0, 0, time.UTC)
+	if date.Day() != values["DD"] || int(date.Month()) != values["MM"] {
+		return time.Time{}, fmt.Errorf("%w %q", ErrInvalidDate, s)
+	}
+
+	return date, nil
+}
@@ -0,0 +1,207 @@
+package imports
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"backend-master/internal/api-gen/proto/common"
+	walletctrl "backend-master/internal/domain/controllers/wallet"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/transform"
+)
+
+// maxImportRows bounds how many rows a single statement may hold
+const maxImportRows = 10000
+
+var (
+	ErrTooManyRows     = fmt.Errorf("statement must not have more than %d rows", maxImportRows)
+	ErrMissingValue    = errors.New("missing value")
+	ErrInvalidDate     = errors.New("invalid date")
+	ErrInvalidAmount   = errors.New("invalid amount")
+	ErrZeroAmount      = errors.New("amount must not be zero")
+	ErrInvalidCurrency = errors.New("currency must be a 3-letter ISO 4217 code")
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// statementRow is a parsed statement row or the reason it could not be
+// parsed. Line is the 1-based line of the row in the file, or its position
+// among the transactions for formats that are not line based.
+type statementRow struct {
+	line int
+	// accountID is the statement account, the transaction is booked in it
+	accountID uuid.UUID
+	tx        walletctrl.ImportedTransaction
+	signed    int64 // negative for money leaving the account
+	// transfer is set when the statement marks the row as a transfer
+	// between the user's own accounts
+	transfer bool
+	err      error
+}
+
+// decode returns content converted from the named encoding to UTF-8.
+func decode(content []byte, encoding string) (io.Reader, error) {
+	enc, err := htmlindex.Get(encoding)
+	if err != nil {
+		return nil, ErrUnknownEncoding
+	}
+	if name, _ := htmlindex.Name(enc); name == "utf-8" {
+		content = bytes.TrimPrefix(content, utf8BOM)
+	}
+
+	return transform.NewReader(bytes.NewReader(content), enc.NewDecoder()), nil
+}
+
+// fillType sets the transaction type and amount from the signed amount.
+func (row *statementRow) fillType() {
+	if row.signed < 0 {
+		row.tx.Type = common.TransactionType_TRANSACTION_TYPE_EXPENSE
+		row.tx.Amount = -row.signed
+	} else {
+		row.tx.Type = common.TransactionType_TRANSACTION_TYPE_INCOME
+		row.tx.Amount = row.signed
+	}
+}
+
+// asTransfer turns the row into a transfer between the statement account
+// and the other account, in the direction the money moved.
+func (row *statementRow) asTransfer(otherID uuid.UUID) {
+	row.tx.Type = common.TransactionType_TRANSACTION_TYPE_TRANSFER
+	if row.signed < 0 {
+		row.tx.ToAccountID = otherID.String()
+	} else {
+		row.tx.FromAccountID = otherID.String()
+	}
+}
+
+func parseCurrency(s string) (string, error) {
+	cur := strings.ToUpper(strings.TrimSpace(s))
+	if len(cur) != 3 || strings.IndexFunc(cur, func(r rune) bool { return r < 'A' || r > 'Z' }) >= 0 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCurrency, s)
+	}
+	return cur, nil
+}
+
+// joinDescription combines a payee and a memo, dropping the memo when it
+// only repeats the payee.
+func joinDescription(payee string, memo string) string {
+	payee = strings.TrimSpace(payee)
+	memo = strings.TrimSpace(memo)
+
+	switch {
+	case memo == "" || strings.EqualFold(payee, memo):
+		return payee
+	case payee == "":
+		return memo
+	default:
+		return payee + " " + memo
+	}
+}
+
+// parseAmount converts a statement amount into minor units. Spaces are
+// ignored and thousands separators must group the whole part by three
+// digits; the amount may be signed with a leading or trailing minus or
+// wrapped in parentheses, but not both.
+func parseAmount(s string, decimalSeparator string) (int64, error) {
+	raw := s
+
+	thousands := ","
+	if decimalSeparator == "," {
+		thousands = "."
+	}
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '\'' {
+			return -1
+		}
+		return r
+	}, s)
+
+	negative, parenthesized := false, false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative, parenthesized = true, true
+		s = s[1 : len(s)-1]
+	}
+	signed := true
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasSuffix(s, "-"):
+		negative = true
+		s = s[:len(s)-1]
+	default:
+		signed = false
+	}
+	if parenthesized && signed {
+		return 0, fmt.Errorf("%w %q", ErrInvalidAmount, raw)
+	}
+
+	whole, frac, _ := strings.Cut(s, decimalSeparator)
+	whole, ok := ungroup(whole, thousands)
+	if !ok || whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("%w %q", ErrInvalidAmount, raw)
+	}
+	if len(frac) > 2 {
+		if strings.Trim(frac[2:], "0") != "" {
+			return 0, fmt.Errorf("%w %q: more than 2 decimal places", ErrInvalidAmount, raw)
+		}
+		frac = frac[:2]
+	}
+	frac += strings.Repeat("0", 2-len(frac))
+
+	amount, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q", ErrInvalidAmount, raw)
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return amount, nil
+}
+
+// ungroup removes the thousands separators from the whole part of an
+// amount. It reports false unless every group after the first has exactly
+// three digits, so "12,34" is not read as 1234 when "." is the decimal
+// separator.
+func ungroup(whole string, thousands string) (string, bool) {
+	groups := strings.Split(whole, thousands)
+	if len(groups) == 1 {
+		return whole, true
+	}
+	if n := len(groups[0]); n == 0 || n > 3 {
+		return "", false
+	}
+	for _, g := range groups[1:] {
+		if len(g) != 3 {
+			return "", false
+		}
+	}
+
+	return strings.Join(groups, ""), true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
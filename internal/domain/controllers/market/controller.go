@@ -2,24 +2,53 @@ package market
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"backend-master/internal/api-gen/proto/common"
 	pb "backend-master/internal/api-gen/proto/market"
+	masterpb "backend-master/internal/api-gen/proto/master"
 	"backend-master/internal/data/repositories/market"
+	"backend-master/internal/data/repositories/wallet"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var (
+	ErrNoFigis               = errors.New("at least one FIGI is required")
+	ErrInvalidDateWindow     = errors.New("end date must be set and after start date")
+	ErrIncompleteCredentials = errors.New("backend type, external account ID and token are required")
+	ErrNotInvestmentAccount  = errors.New("account is not an investment account")
+)
+
 type MarketController interface {
+	// GetInvestmentPositions returns the positions of the user's linked
+	// investment account.
 	GetInvestmentPositions(
 		ctx context.Context,
+		userID string,
 		accountID string,
 	) (*pb.GetInvestmentPositionsResponse, error)
 
+	// LinkBroker stores the broker credentials of one of the user's
+	// investment accounts, replacing the ones linked before.
+	LinkBroker(
+		ctx context.Context,
+		userID string,
+		accountID string,
+		backendType string,
+		externalAccountID string,
+		token string,
+	) (*masterpb.BrokerLink, error)
+
+	UnlinkBroker(
+		ctx context.Context,
+		userID string,
+		accountID string,
+	) error
+
 	GetSecurity(
 		ctx context.Context,
 		figi string,
@@ -32,44 +61,66 @@ type MarketController interface {
 
 	GetSecurityPayments(
 		ctx context.Context,
-		figi string,
+		figis []string,
+		startDate time.Time,
+		endDate time.Time,
 	) (*pb.GetSecuritiesPaymentsResponse, error)
 }
 
 type marketControllerImpl struct {
-	client *market.MarketClient
-	logger *zap.Logger
+	repo       market.MarketRepository
+	walletRepo wallet.WalletRepository
+	client     *market.MarketClient
+	logger     *zap.Logger
 }
 
 func NewController(
+	repo market.MarketRepository,
+	walletRepo wallet.WalletRepository,
 	client *market.MarketClient,
 	logger *zap.Logger,
 ) MarketController {
 	return &marketControllerImpl{
-		client: client,
-		logger: logger,
+		repo:       repo,
+		walletRepo: walletRepo,
+		client:     client,
+		logger:     logger,
 	}
 }
 
 func (cont *marketControllerImpl) GetInvestmentPositions(
 	ctx context.Context,
+	userID string,
 	accountID string,
 ) (*pb.GetInvestmentPositionsResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
 	aid, err := uuid.Parse(accountID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid account ID: %w", err)
 	}
 
+	link, err := cont.repo.GetBrokerLink(ctx, aid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get broker credentials: %w", err)
+	}
+	if link.UserID != uid {
+		return nil, fmt.Errorf(
+			"failed to get broker credentials for aid %s: %w",
+			aid.String(),
+			market.ErrBrokerLinkNotFound,
+		)
+	}
+
 	positions, err := cont.client.GetInvestmentPositions(
 		ctx,
 		&pb.GetInvestmentPositionsRequest{
 			AccountId: aid.String(),
-			UserId:    "uid",
-			Backend: &common.AccountBackend{
-				Type:      "TInvest",
-				AccountId: "aid",
-				Token:     "tok",
-			},
+			UserId:    uid.String(),
+			Backend:   link.ToBackend(),
 		},
 	)
 	if err != nil {
@@ -79,10 +130,79 @@ func (cont *marketControllerImpl) GetInvestmentPositions(
 	return positions, nil
 }
 
+func (cont *marketControllerImpl) LinkBroker(
+	ctx context.Context,
+	userID string,
+	accountID string,
+	backendType string,
+	externalAccountID string,
+	token string,
+) (*masterpb.BrokerLink, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	aid, err := uuid.Parse(accountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	if backendType == "" || externalAccountID == "" || token == "" {
+		return nil, ErrIncompleteCredentials
+	}
+
+	if err := cont.checkInvestmentAccount(ctx, uid, aid); err != nil {
+		return nil, err
+	}
+
+	link, err := cont.repo.UpsertBrokerLink(
+		ctx,
+		&market.BrokerLink{
+			AccountID:         aid,
+			UserID:            uid,
+			BackendType:       backendType,
+			ExternalAccountID: externalAccountID,
+			Token:             token,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save broker link in repository: %w", err)
+	}
+
+	return link.ToProto(), nil
+}
+
+func (cont *marketControllerImpl) UnlinkBroker(
+	ctx context.Context,
+	userID string,
+	accountID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	aid, err := uuid.Parse(accountID)
+	if err != nil {
+		return fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	if err := cont.repo.DeleteBrokerLink(ctx, uid, aid); err != nil {
+		return fmt.Errorf("failed to delete broker link in repository: %w", err)
+	}
+
+	return nil
+}
+
 func (cont *marketControllerImpl) GetSecurity(
 	ctx context.Context,
 	figi string,
 ) (*pb.GetSecurityResponse, error) {
+	if figi == "" {
+		return nil, ErrNoFigis
+	}
+
 	security, err := cont.client.GetSecurity(
 		ctx,
 		&pb.GetSecurityRequest{Figi: figi},
@@ -97,6 +217,10 @@ func (cont *marketControllerImpl) GetSecuritiesPrices(
 	ctx context.Context,
 	figis []string,
 ) (*pb.GetSecuritiesPricesResponse, error) {
+	if len(figis) == 0 {
+		return nil, ErrNoFigis
+	}
+
 	securities, err := cont.client.GetSecuritiesPrices(
 		ctx,
 		&pb.GetSecuritiesPricesRequest{Figis: figis},
@@ -109,14 +233,27 @@ func (cont *marketControllerImpl) GetSecuritiesPrices(
 
 func (cont *marketControllerImpl) GetSecurityPayments(
 	ctx context.Context,
-	figi string,
+	figis []string,
+	startDate time.Time,
+	endDate time.Time,
 ) (*pb.GetSecuritiesPaymentsResponse, error) {
+	if len(figis) == 0 {
+		return nil, ErrNoFigis
+	}
+
+	if startDate.IsZero() {
+		startDate = time.Now()
+	}
+	if endDate.IsZero() || !endDate.After(startDate) {
+		return nil, ErrInvalidDateWindow
+	}
+
 	payments, err := cont.client.GetSecurityPayments(
 		ctx,
 		&pb.GetSecuritiesPaymentsRequest{
-			Figis:     []string{figi},
-			StartDate: timestamppb.New(time.Now().AddDate(0, -6, 0)),
-			EndDate:   timestamppb.New(time.Now().AddDate(0, 6, 0)),
+			Figis:     figis,
+			StartDate: timestamppb.New(startDate),
+			EndDate:   timestamppb.New(endDate),
 		},
 	)
 	if err != nil {
@@ -124,3 +261,29 @@ func (cont *marketControllerImpl) GetSecurityPayments(
 	}
 	return payments, nil
 }
+
+// checkInvestmentAccount makes sure the account is an INVESTMENT account of
+// the user.
+func (cont *marketControllerImpl) checkInvestmentAccount(
+	ctx context.Context,
+	userID uuid.UUID,
+	accountID uuid.UUID,
+) error {
+	accounts, err := cont.walletRepo.GetAccountsByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from repository: %w", err)
+	}
+
+	for _, acc := range accounts {
+		if acc.ID != accountID {
+			continue
+		}
+
+		if acc.Type != "INVESTMENT" {
+			return fmt.Errorf("account %s: %w", accountID.String(), ErrNotInvestmentAccount)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("account %s: %w", accountID.String(), wallet.ErrAccountNotFound)
+}
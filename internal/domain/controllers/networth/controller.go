@@ -0,0 +1,378 @@
+package networth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	marketpb "backend-master/internal/api-gen/proto/market"
+	pb "backend-master/internal/api-gen/proto/master"
+	walletpb "backend-master/internal/api-gen/proto/wallet"
+	currencyRepo "backend-master/internal/data/repositories/currency"
+	marketRepo "backend-master/internal/data/repositories/market"
+	"backend-master/internal/domain/controllers/currency"
+	"backend-master/internal/domain/controllers/market"
+	"backend-master/internal/domain/controllers/wallet"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type NetWorthController interface {
+	// GetNetWorth values REGULAR accounts by their balance and INVESTMENT
+	// accounts by their broker positions at current market prices, all
+	// converted to targetCurrency. An account without an exchange rate is
+	// flagged RateMissing and left out of the totals; a cash account then
+	// keeps its balance in its own currency and an investment account has no
+	// value.
+	GetNetWorth(
+		ctx context.Context,
+		userID string,
+		targetCurrency string,
+	) (*pb.GetNetWorthResponse, error)
+}
+
+type netWorthControllerImpl struct {
+	walletCtrl   wallet.WalletController
+	marketCtrl   market.MarketController
+	currencyCtrl currency.CurrencyController
+	logger       *zap.Logger
+}
+
+func NewController(
+	walletCtrl wallet.WalletController,
+	marketCtrl market.MarketController,
+	currencyCtrl currency.CurrencyController,
+	logger *zap.Logger,
+) NetWorthController {
+	return &netWorthControllerImpl{
+		walletCtrl:   walletCtrl,
+		marketCtrl:   marketCtrl,
+		currencyCtrl: currencyCtrl,
+		logger:       logger,
+	}
+}
+
+// valuation accumulates net worth while accounts are processed.
+type valuation struct {
+	currency   string
+	now        time.Time
+	cash       int64
+	invested   int64
+	valuedAt   time.Time
+	accounts   []*pb.NetWorthAccount
+	securities map[string]*pb.NetWorthSecurity
+	byType     map[string]int64
+}
+
+func (cont *netWorthControllerImpl) GetNetWorth(
+	ctx context.Context,
+	userID string,
+	targetCurrency string,
+) (*pb.GetNetWorthResponse, error) {
+	targetCurrency = strings.ToUpper(targetCurrency)
+	if targetCurrency == "" {
+		targetCurrency = currency.PivotCurrency
+	}
+
+	accountsResp, err := cont.walletCtrl.GetUserAccounts(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user accounts: %w", err)
+	}
+
+	v := &valuation{
+		currency:   targetCurrency,
+		now:        time.Now(),
+		securities: map[string]*pb.NetWorthSecurity{},
+		byType:     map[string]int64{},
+	}
+	v.valuedAt = v.now
+
+	for _, acc := range accountsResp.Accounts {
+		if acc.Type == common.AccountType_ACCOUNT_TYPE_INVESTMENT {
+			err = cont.valueInvestmentAccount(ctx, v, userID, acc)
+		} else {
+			err = cont.valueCashAccount(ctx, v, acc)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return v.toProto(), nil
+}
+
+func (cont *netWorthControllerImpl) valueCashAccount(
+	ctx context.Context,
+	v *valuation,
+	acc *walletpb.Account,
+) error {
+	value, err := cont.convert(ctx, v, acc.GetBalance())
+	if errors.Is(err, currencyRepo.ErrRateNotFound) {
+		cont.rateMissing(v, acc, acc.GetBalance(), err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to value account %s: %w", acc.AccountId, err)
+	}
+
+	v.cash += value
+	v.accounts = append(v.accounts, &pb.NetWorthAccount{
+		AccountId: acc.AccountId,
+		Name:      acc.Name,
+		Type:      acc.Type,
+		Value:     v.money(value),
+		ValuedAt:  timestamppb.New(v.now),
+	})
+
+	return nil
+}
+
+func (cont *netWorthControllerImpl) valueInvestmentAccount(
+	ctx context.Context,
+	v *valuation,
+	userID string,
+	acc *walletpb.Account,
+) error {
+	positionsResp, err := cont.marketCtrl.GetInvestmentPositions(ctx, userID, acc.AccountId)
+	if errors.Is(err, marketRepo.ErrBrokerLinkNotFound) {
+		// without broker credentials the stored balance is the best we know
+		cont.logger.Warn(
+			"investment account has no broker link, using stored balance",
+			zap.String("account_id", acc.AccountId),
+		)
+		return cont.valueCashAccount(ctx, v, acc)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get positions of account %s: %w", acc.AccountId, err)
+	}
+
+	positions := positionsResp.Positions
+
+	securities, err := cont.getSecurities(ctx, positions)
+	if err != nil {
+		return err
+	}
+
+	type valuedPosition struct {
+		pos       *marketpb.InvestmentPosition
+		security  *marketpb.Security
+		unitPrice int64
+		value     int64
+		priceAt   time.Time
+	}
+
+	var (
+		accountValue int64
+		accountAt    = v.now
+		valued       = make([]valuedPosition, 0, len(positions))
+	)
+
+	for _, pos := range positions {
+		price := pos.GetPrice()
+		priceAt := v.now
+
+		security := securities[pos.Figi]
+		if security != nil && security.CurrentPrice != nil {
+			price = security.CurrentPrice
+			if security.PriceUpdatedAt != nil {
+				priceAt = security.PriceUpdatedAt.AsTime()
+			}
+		}
+
+		unitPrice, value, err := cont.valuePosition(ctx, v, price, pos.Quantity)
+		if errors.Is(err, currencyRepo.ErrRateNotFound) {
+			cont.rateMissing(v, acc, nil, err)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to value security %s: %w", pos.Figi, err)
+		}
+
+		valued = append(valued, valuedPosition{pos, security, unitPrice, value, priceAt})
+	}
+
+	for _, p := range valued {
+		accountValue += p.value
+		if p.priceAt.Before(accountAt) {
+			accountAt = p.priceAt
+		}
+
+		v.addSecurity(p.pos, p.security, p.unitPrice, p.value, p.priceAt)
+	}
+
+	v.invested += accountValue
+	if accountAt.Before(v.valuedAt) {
+		v.valuedAt = accountAt
+	}
+
+	v.accounts = append(v.accounts, &pb.NetWorthAccount{
+		AccountId: acc.AccountId,
+		Name:      acc.Name,
+		Type:      acc.Type,
+		Value:     v.money(accountValue),
+		ValuedAt:  timestamppb.New(accountAt),
+	})
+
+	return nil
+}
+
+// valuePosition converts the unit price and the value of a position. The
+// position is multiplied out before it is converted, so the rounding of the
+// unit price is not multiplied by the quantity.
+func (cont *netWorthControllerImpl) valuePosition(
+	ctx context.Context,
+	v *valuation,
+	price *common.Money,
+	quantity int32,
+) (int64, int64, error) {
+	unitPrice, err := cont.convert(ctx, v, price)
+	if err != nil || price == nil {
+		return unitPrice, 0, err
+	}
+
+	value, err := cont.convert(ctx, v, &common.Money{
+		Amount:   price.Amount * int64(quantity),
+		Currency: price.Currency,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return unitPrice, value, nil
+}
+
+// rateMissing reports an account that cannot be converted to the target
+// currency without adding it to the totals.
+func (cont *netWorthControllerImpl) rateMissing(
+	v *valuation,
+	acc *walletpb.Account,
+	value *common.Money,
+	err error,
+) {
+	cont.logger.Warn(
+		"no exchange rate for account value",
+		zap.String("account_id", acc.AccountId),
+		zap.Error(err),
+	)
+	v.accounts = append(v.accounts, &pb.NetWorthAccount{
+		AccountId:   acc.AccountId,
+		Name:        acc.Name,
+		Type:        acc.Type,
+		Value:       value,
+		ValuedAt:    timestamppb.New(v.now),
+		RateMissing: true,
+	})
+}
+
+func (cont *netWorthControllerImpl) getSecurities(
+	ctx context.Context,
+	positions []*marketpb.InvestmentPosition,
+) (map[string]*marketpb.Security, error) {
+	securities := map[string]*marketpb.Security{}
+	if len(positions) == 0 {
+		return securities, nil
+	}
+
+	figis := make([]string, 0, len(positions))
+	for _, pos := range positions {
+		figis = append(figis, pos.Figi)
+	}
+
+	pricesResp, err := cont.marketCtrl.GetSecuritiesPrices(ctx, figis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get securities prices: %w", err)
+	}
+
+	for _, security := range pricesResp.Securities {
+		securities[security.Figi] = security
+	}
+
+	return securities, nil
+}
+
+func (cont *netWorthControllerImpl) convert(
+	ctx context.Context,
+	v *valuation,
+	money *common.Money,
+) (int64, error) {
+	if money == nil {
+		return 0, nil
+	}
+
+	conversion, err := cont.currencyCtrl.Convert(ctx, money.Amount, money.Currency, v.currency, v.now)
+	if err != nil {
+		return 0, err
+	}
+
+	return conversion.Amount, nil
+}
+
+func (v *valuation) addSecurity(
+	pos *marketpb.InvestmentPosition,
+	security *marketpb.Security,
+	unitPrice int64,
+	value int64,
+	priceAt time.Time,
+) {
+	item, ok := v.securities[pos.Figi]
+	if !ok {
+		item = &pb.NetWorthSecurity{
+			Figi:           pos.Figi,
+			Price:          v.money(unitPrice),
+			Value:          v.money(0),
+			PriceUpdatedAt: timestamppb.New(priceAt),
+		}
+		if security != nil {
+			item.Name = security.Name
+			item.Type = security.Type
+		}
+		v.securities[pos.Figi] = item
+	}
+
+	item.Quantity += int64(pos.Quantity)
+	item.Value.Amount += value
+	v.byType[item.Type] += value
+}
+
+func (v *valuation) money(amount int64) *common.Money {
+	return &common.Money{
+		Amount:   amount,
+		Currency: v.currency,
+	}
+}
+
+func (v *valuation) toProto() *pb.GetNetWorthResponse {
+	securities := make([]*pb.NetWorthSecurity, 0, len(v.securities))
+	for _, security := range v.securities {
+		securities = append(securities, security)
+	}
+	sort.Slice(securities, func(i, j int) bool {
+		return securities[i].Value.Amount > securities[j].Value.Amount
+	})
+
+	types := make([]*pb.NetWorthSecurityType, 0, len(v.byType))
+	for securityType, value := range v.byType {
+		types = append(types, &pb.NetWorthSecurityType{
+			Type:  securityType,
+			Value: v.money(value),
+		})
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Value.Amount > types[j].Value.Amount
+	})
+
+	return &pb.GetNetWorthResponse{
+		Total:            v.money(v.cash + v.invested),
+		CashTotal:        v.money(v.cash),
+		InvestmentsTotal: v.money(v.invested),
+		Accounts:         v.accounts,
+		Securities:       securities,
+		SecurityTypes:    types,
+		ValuedAt:         timestamppb.New(v.valuedAt),
+	}
+}
@@ -4,36 +4,73 @@ import (
 	"context"
 	"fmt"
 
-	pb "backend-master/internal/api-gen/proto/notification"
+	masterpb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/database"
 	"backend-master/internal/data/repositories/notification"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
 type NotificationController interface {
+	// SendNotification queues a notification for delivery. When ctx carries a
+	// database transaction the notification is stored as part of it, so it
+	// is only sent if the triggering change commits.
 	SendNotification(
 		ctx context.Context,
 		userID string,
 		title string,
 		message string,
-	) (*pb.SendNotificationResponse, error)
+	) (*masterpb.Notification, error)
+
+	ListNotifications(
+		ctx context.Context,
+		userID string,
+		pageSize int32,
+		pageToken string,
+		unreadOnly bool,
+	) (*NotificationsPage, error)
+
+	MarkRead(
+		ctx context.Context,
+		userID string,
+		notificationIDs []string,
+		read bool,
+	) (int64, error)
+
+	DeleteNotification(
+		ctx context.Context,
+		userID string,
+		notificationID string,
+	) error
+
+	GetUnreadCount(
+		ctx context.Context,
+		userID string,
+	) (int64, error)
+}
+
+type NotificationsPage struct {
+	Notifications []*masterpb.Notification
+	NextPageToken string
 }
 
 type notificationControllerImpl struct {
 	repo   notification.NotificationRepository
-	client *notification.NotificationClient
 	logger *zap.Logger
 }
 
 func NewController(
 	repo notification.NotificationRepository,
-	client *notification.NotificationClient,
 	logger *zap.Logger,
 ) NotificationController {
 	return &notificationControllerImpl{
 		repo:   repo,
-		client: client,
 		logger: logger,
 	}
 }
@@ -43,30 +80,140 @@ func (cont *notificationControllerImpl) SendNotification(
 	userID string,
 	title string,
 	message string,
-) (*pb.SendNotificationResponse, error) {
+) (*masterpb.Notification, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	n, err := cont.repo.CreateNotification(ctx, uid, title, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue notification in repository: %w", err)
+	}
+
+	return n.ToMasterProto(), nil
+}
+
+func (cont *notificationControllerImpl) ListNotifications(
+	ctx context.Context,
+	userID string,
+	pageSize int32,
+	pageToken string,
+	unreadOnly bool,
+) (*NotificationsPage, error) {
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	req := &pb.SendNotificationRequest{
-		UserId:  userID,
-		Title:   title,
-		Message: message,
+	limit := int(pageSize)
+	switch {
+	case limit <= 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+
+	var after *database.Cursor
+	if pageToken != "" {
+		after, err = database.DecodeCursor(pageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// one extra row tells whether there is a next page
+	notifications, err := cont.repo.GetNotificationsByUserID(ctx, uid, limit+1, after, unreadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications from repository: %w", err)
+	}
+
+	page := &NotificationsPage{}
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+
+		last := notifications[len(notifications)-1]
+		page.NextPageToken = database.Cursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}.Encode()
 	}
 
-	resp, err := cont.client.SendNotification(ctx, req)
+	page.Notifications = make([]*masterpb.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		page.Notifications = append(page.Notifications, n.ToMasterProto())
+	}
+
+	return page, nil
+}
+
+func (cont *notificationControllerImpl) MarkRead(
+	ctx context.Context,
+	userID string,
+	notificationIDs []string,
+	read bool,
+) (int64, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(notificationIDs))
+	for _, notificationID := range notificationIDs {
+		id, err := uuid.Parse(notificationID)
+		if err != nil {
+			return 0, fmt.Errorf("invalid notification ID %q: %w", notificationID, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	updated, err := cont.repo.SetNotificationsRead(ctx, uid, ids, read)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications in repository: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (cont *notificationControllerImpl) DeleteNotification(
+	ctx context.Context,
+	userID string,
+	notificationID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	id, err := uuid.Parse(notificationID)
+	if err != nil {
+		return fmt.Errorf("invalid notification ID: %w", err)
+	}
+
+	if err := cont.repo.DeleteNotification(ctx, uid, id); err != nil {
+		return fmt.Errorf("failed to delete notification in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (cont *notificationControllerImpl) GetUnreadCount(
+	ctx context.Context,
+	userID string,
+) (int64, error) {
+	uid, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send notification  via client: %w", err)
+		return 0, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	_, err = cont.repo.CreateNotification(ctx, uid, title, message)
+	count, err := cont.repo.CountUnread(ctx, uid)
 	if err != nil {
-		cont.logger.Error(
-			"failed to log notification to database",
-			zap.Error(err),
-		)
+		return 0, fmt.Errorf("failed to count unread notifications in repository: %w", err)
 	}
 
-	return resp, nil
+	return count, nil
 }
@@ -0,0 +1,167 @@
+package notification
+
+import (
+	"backend-master/configs"
+	"backend-master/internal/data/repositories/notification"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	deliveryTimeout = 10 * time.Second
+	baseBackoff     = 30 * time.Second
+	maxBackoff      = time.Hour
+)
+
+// Dispatcher delivers queued notifications through the notification
+// service. Failed deliveries are retried with exponential backoff until the
+// attempt limit is reached, after which the notification is marked DEAD.
+type Dispatcher struct {
+	repo   notification.NotificationRepository
+	client *notification.NotificationClient
+	cfg    configs.OutboxConfig
+	logger *zap.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewDispatcher(
+	repo notification.NotificationRepository,
+	client *notification.NotificationClient,
+	cfg configs.OutboxConfig,
+	logger *zap.Logger,
+) *Dispatcher {
+	return &Dispatcher{
+		repo:   repo,
+		client: client,
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+func (d *Dispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		ticker := time.NewTicker(d.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			d.dispatch(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop waits for the delivery in flight to finish. The rest of the batch is
+// left pending for the next start.
+func (d *Dispatcher) Stop() {
+	if d.cancel == nil {
+		return
+	}
+	d.cancel()
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	// the lease outlives every delivery in the batch, so a notification is
+	// only picked up again if this dispatcher died while delivering it
+	lease := deliveryTimeout*time.Duration(d.cfg.BatchSize) + d.cfg.PollInterval
+
+	notifications, err := d.repo.ClaimPending(ctx, d.cfg.BatchSize, lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			d.logger.Error("failed to claim pending notifications", zap.Error(err))
+		}
+		return
+	}
+
+	for i := range notifications {
+		if ctx.Err() != nil {
+			d.release(notifications[i:])
+			return
+		}
+		d.deliver(ctx, &notifications[i])
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, n *notification.Notification) {
+	sendCtx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	_, sendErr := d.client.SendNotification(sendCtx, n.ToProto())
+	cancel()
+
+	// results are recorded even when shutting down, otherwise a delivered
+	// notification would be sent again after the lease expires
+	recordCtx := context.WithoutCancel(ctx)
+
+	if sendErr == nil {
+		if err := d.repo.MarkSent(recordCtx, n.ID); err != nil {
+			d.logger.Error("failed to mark notification as sent", zap.Error(err))
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		// cut short by shutdown, which is not a failed attempt
+		d.release([]notification.Notification{*n})
+		return
+	}
+
+	attempts := n.Attempts + 1
+
+	var retryAt *time.Time
+	if attempts < d.cfg.MaxAttempts {
+		next := time.Now().Add(backoff(attempts))
+		retryAt = &next
+	} else {
+		d.logger.Warn(
+			"notification delivery gave up",
+			zap.String("notification_id", n.ID.String()),
+			zap.Int("attempts", attempts),
+			zap.Error(sendErr),
+		)
+	}
+
+	if err := d.repo.MarkFailed(recordCtx, n.ID, sendErr.Error(), retryAt); err != nil {
+		d.logger.Error("failed to record notification delivery failure", zap.Error(err))
+	}
+}
+
+// release returns claimed notifications to the queue when shutting down.
+func (d *Dispatcher) release(notifications []notification.Notification) {
+	ids := make([]uuid.UUID, 0, len(notifications))
+	for _, n := range notifications {
+		ids = append(ids, n.ID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+	defer cancel()
+
+	if err := d.repo.ReleaseClaims(ctx, ids); err != nil {
+		d.logger.Error("failed to release claimed notifications", zap.Error(err))
+	}
+}
+
+func backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
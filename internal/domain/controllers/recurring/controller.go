@@ -0,0 +1,393 @@
+package recurring
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	masterpb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/repositories/recurring"
+	"backend-master/internal/data/repositories/wallet"
+	"backend-master/internal/domain/controllers/category"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrRecurringAccount   = errors.New("recurring transaction account not found")
+	ErrInvalidType        = errors.New("recurring transaction type must be income, expense or transfer")
+	ErrInvalidAmount      = errors.New("recurring transaction amount must be positive")
+	ErrInvalidFrequency   = errors.New("unknown recurrence frequency")
+	ErrInvalidInterval    = errors.New("recurrence interval must not be negative")
+	ErrInvalidDayOfMonth  = errors.New("day of month must be between 1 and 31")
+	ErrDayOfMonthNotAllow = errors.New("day of month is only used by monthly and yearly schedules")
+	ErrStartDateRequired  = errors.New("recurring transaction requires a start date")
+	ErrInvalidEndDate     = errors.New("end date is before the start date")
+	ErrInvalidCount       = errors.New("occurrence count must not be negative")
+	ErrTransferTarget     = errors.New("transfer requires a target account of the user other than the source")
+)
+
+type RecurringController interface {
+	ListRecurring(
+		ctx context.Context,
+		userID string,
+	) ([]*masterpb.RecurringTransaction, error)
+
+	CreateRecurring(
+		ctx context.Context,
+		userID string,
+		recurring *masterpb.RecurringTransaction,
+	) (*masterpb.RecurringTransaction, error)
+
+	// SetPaused pauses or resumes a template. Occurrences that fell due
+	// while it was paused are skipped on resume.
+	SetPaused(
+		ctx context.Context,
+		userID string,
+		recurringID string,
+		paused bool,
+	) (*masterpb.RecurringTransaction, error)
+
+	// SkipNext moves a template past its next occurrence without creating it.
+	SkipNext(
+		ctx context.Context,
+		userID string,
+		recurringID string,
+	) (*masterpb.RecurringTransaction, error)
+
+	DeleteRecurring(
+		ctx context.Context,
+		userID string,
+		recurringID string,
+	) error
+}
+
+type recurringControllerImpl struct {
+	repo         recurring.RecurringRepository
+	walletRepo   wallet.WalletRepository
+	categoryCtrl category.CategoryController
+	logger       *zap.Logger
+}
+
+func NewController(
+	repo recurring.RecurringRepository,
+	walletRepo wallet.WalletRepository,
+	categoryCtrl category.CategoryController,
+	logger *zap.Logger,
+) RecurringController {
+	return &recurringControllerImpl{
+		repo:         repo,
+		walletRepo:   walletRepo,
+		categoryCtrl: categoryCtrl,
+		logger:       logger,
+	}
+}
+
+func (cont *recurringControllerImpl) ListRecurring(
+	ctx context.Context,
+	userID string,
+) ([]*masterpb.RecurringTransaction, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	templates, err := cont.repo.GetRecurringByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recurring transactions from repository: %w", err)
+	}
+
+	pbTemplates := make([]*masterpb.RecurringTransaction, 0, len(templates))
+	for _, r := range templates {
+		pbTemplates = append(pbTemplates, r.ToProto())
+	}
+
+	return pbTemplates, nil
+}
+
+func (cont *recurringControllerImpl) CreateRecurring(
+	ctx context.Context,
+	userID string,
+	pbRecurring *masterpb.RecurringTransaction,
+) (*masterpb.RecurringTransaction, error) {
+	r, err := cont.newRecurring(ctx, userID, pbRecurring)
+	if err != nil {
+		return nil, err
+	}
+	r.NextRunAt = nextRunAt(r)
+
+	created, err := cont.repo.CreateRecurring(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recurring transaction in repository: %w", err)
+	}
+
+	return created.ToProto(), nil
+}
+
+func (cont *recurringControllerImpl) SetPaused(
+	ctx context.Context,
+	userID string,
+	recurringID string,
+	paused bool,
+) (*masterpb.RecurringTransaction, error) {
+	return cont.updateSchedule(ctx, userID, recurringID, func(r *recurring.Recurring) {
+		if r.Paused == paused {
+			return
+		}
+		r.Paused = paused
+
+		if !paused {
+			r.LastError = sql.NullString{}
+
+			now := time.Now()
+			for due(r, now) {
+				advance(r)
+			}
+		}
+	})
+}
+
+func (cont *recurringControllerImpl) SkipNext(
+	ctx context.Context,
+	userID string,
+	recurringID string,
+) (*masterpb.RecurringTransaction, error) {
+	return cont.updateSchedule(ctx, userID, recurringID, func(r *recurring.Recurring) {
+		if r.NextRunAt.Valid {
+			advance(r)
+		}
+	})
+}
+
+func (cont *recurringControllerImpl) DeleteRecurring(
+	ctx context.Context,
+	userID string,
+	recurringID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	rid, err := uuid.Parse(recurringID)
+	if err != nil {
+		return fmt.Errorf("invalid recurring transaction ID: %w", err)
+	}
+
+	if err := cont.repo.DeleteRecurring(ctx, uid, rid); err != nil {
+		return fmt.Errorf("failed to delete recurring transaction in repository: %w", err)
+	}
+
+	return nil
+}
+
+// updateSchedule changes the template with fn while it is locked, so the
+// change does not race with the scheduler creating its next occurrence.
+func (cont *recurringControllerImpl) updateSchedule(
+	ctx context.Context,
+	userID string,
+	recurringID string,
+	fn func(r *recurring.Recurring),
+) (*masterpb.RecurringTransaction, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	rid, err := uuid.Parse(recurringID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurring transaction ID: %w", err)
+	}
+
+	var updated *recurring.Recurring
+	err = cont.repo.WithinTx(ctx, func(ctx context.Context) error {
+		r, err := cont.repo.GetRecurringForUpdate(ctx, uid, rid)
+		if err != nil {
+			return fmt.Errorf("failed to get recurring transaction from repository: %w", err)
+		}
+
+		fn(r)
+
+		updated, err = cont.repo.UpdateSchedule(ctx, r)
+		if err != nil {
+			return fmt.Errorf("failed to update recurring transaction in repository: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated.ToProto(), nil
+}
+
+func (cont *recurringControllerImpl) newRecurring(
+	ctx context.Context,
+	userID string,
+	pbRecurring *masterpb.RecurringTransaction,
+) (*recurring.Recurring, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if pbRecurring == nil || pbRecurring.StartDate == nil {
+		return nil, ErrStartDateRequired
+	}
+
+	r := &recurring.Recurring{
+		UserID:    uid,
+		Frequency: recurring.FrequencyPbTypeToDbType(pbRecurring.Frequency),
+		Interval:  pbRecurring.Interval,
+		StartDate: pbRecurring.StartDate.AsTime(),
+	}
+
+	switch pbRecurring.Type {
+	case common.TransactionType_TRANSACTION_TYPE_INCOME,
+		common.TransactionType_TRANSACTION_TYPE_EXPENSE,
+		common.TransactionType_TRANSACTION_TYPE_TRANSFER:
+		r.Type = wallet.TransactionPbTypeToDbType(pbRecurring.Type)
+	default:
+		return nil, ErrInvalidType
+	}
+
+	if pbRecurring.Amount == nil || pbRecurring.Amount.Amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	r.Amount = pbRecurring.Amount.Amount
+
+	if err := cont.setAccounts(ctx, r, pbRecurring, strings.ToUpper(pbRecurring.Amount.Currency)); err != nil {
+		return nil, err
+	}
+
+	if err := cont.setCategory(ctx, r, pbRecurring.CategoryId); err != nil {
+		return nil, err
+	}
+
+	if description := strings.TrimSpace(pbRecurring.Description); description != "" {
+		r.Description = sql.NullString{String: description, Valid: true}
+	}
+
+	if r.Frequency == "" {
+		return nil, ErrInvalidFrequency
+	}
+
+	switch {
+	case r.Interval < 0:
+		return nil, ErrInvalidInterval
+	case r.Interval == 0:
+		r.Interval = 1
+	}
+
+	if pbRecurring.DayOfMonth != 0 {
+		if r.Frequency != "MONTHLY" && r.Frequency != "YEARLY" {
+			return nil, ErrDayOfMonthNotAllow
+		}
+		if pbRecurring.DayOfMonth < 1 || pbRecurring.DayOfMonth > 31 {
+			return nil, ErrInvalidDayOfMonth
+		}
+		r.DayOfMonth = sql.NullInt32{Int32: pbRecurring.DayOfMonth, Valid: true}
+	}
+
+	if pbRecurring.EndDate != nil {
+		end := pbRecurring.EndDate.AsTime()
+		if end.Before(r.StartDate) {
+			return nil, ErrInvalidEndDate
+		}
+		r.EndDate = sql.NullTime{Time: end, Valid: true}
+	}
+
+	switch {
+	case pbRecurring.Count < 0:
+		return nil, ErrInvalidCount
+	case pbRecurring.Count > 0:
+		r.Count = sql.NullInt32{Int32: pbRecurring.Count, Valid: true}
+	}
+
+	return r, nil
+}
+
+// setAccounts checks that the accounts of the template belong to the user.
+// The currency defaults to the one of the source account.
+func (cont *recurringControllerImpl) setAccounts(
+	ctx context.Context,
+	r *recurring.Recurring,
+	pbRecurring *masterpb.RecurringTransaction,
+	currency string,
+) error {
+	aid, err := uuid.Parse(pbRecurring.AccountId)
+	if err != nil {
+		return fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	accounts, err := cont.walletRepo.GetAccountsByUserID(ctx, r.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from repository: %w", err)
+	}
+
+	owned := make(map[uuid.UUID]*wallet.Account, len(accounts))
+	for i := range accounts {
+		owned[accounts[i].ID] = &accounts[i]
+	}
+
+	account, ok := owned[aid]
+	if !ok {
+		return ErrRecurringAccount
+	}
+	r.AccountID = aid
+
+	r.Currency = currency
+	if r.Currency == "" {
+		r.Currency = account.Currency
+	}
+
+	if r.Type != "TRANSFER" {
+		return nil
+	}
+
+	toAid, err := uuid.Parse(pbRecurring.ToAccountId)
+	if err != nil || toAid == aid {
+		return ErrTransferTarget
+	}
+	if _, ok := owned[toAid]; !ok {
+		return ErrTransferTarget
+	}
+	r.ToAccountID = uuid.NullUUID{UUID: toAid, Valid: true}
+
+	return nil
+}
+
+// setCategory accepts a category ID or an MCC code, like transactions do.
+func (cont *recurringControllerImpl) setCategory(
+	ctx context.Context,
+	r *recurring.Recurring,
+	categoryID string,
+) error {
+	if categoryID == "" {
+		return nil
+	}
+
+	if mcc, err := strconv.ParseInt(categoryID, 10, 32); err == nil {
+		r.MCC = sql.NullInt32{Int32: int32(mcc), Valid: true}
+		return nil
+	}
+
+	cid, err := uuid.Parse(categoryID)
+	if err != nil {
+		return fmt.Errorf("invalid category ID: %w", err)
+	}
+
+	if _, err := cont.categoryCtrl.GetCategory(ctx, r.UserID, cid); err != nil {
+		return fmt.Errorf("failed to check recurring transaction category: %w", err)
+	}
+	r.CategoryID = uuid.NullUUID{UUID: cid, Valid: true}
+
+	return nil
+}
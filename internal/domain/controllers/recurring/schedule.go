@@ -0,0 +1,84 @@
+package recurring
+
+import (
+	"backend-master/internal/data/repositories/recurring"
+	"database/sql"
+	"time"
+)
+
+// occurrenceAt returns the date of the n-th (0-based) occurrence of the
+// schedule. The first occurrence is the first date of the schedule that is
+// not before its start date.
+func occurrenceAt(r *recurring.Recurring, n int32) time.Time {
+	if scheduleDate(r, 0).Before(r.StartDate) {
+		n++
+	}
+	return scheduleDate(r, n)
+}
+
+// scheduleDate counts periods from the start date. Monthly and yearly
+// schedules fall on the day of month of the template, or of the start date,
+// moved to the last day of shorter months.
+func scheduleDate(r *recurring.Recurring, n int32) time.Time {
+	start := r.StartDate.UTC()
+	periods := int(n) * int(r.Interval)
+
+	var months int
+	switch r.Frequency {
+	case "DAILY":
+		return start.AddDate(0, 0, periods)
+	case "WEEKLY":
+		return start.AddDate(0, 0, 7*periods)
+	case "MONTHLY":
+		months = periods
+	case "YEARLY":
+		months = 12 * periods
+	}
+
+	day := start.Day()
+	if r.DayOfMonth.Valid {
+		day = int(r.DayOfMonth.Int32)
+	}
+
+	first := time.Date(
+		start.Year(),
+		start.Month()+time.Month(months),
+		1,
+		start.Hour(),
+		start.Minute(),
+		start.Second(),
+		start.Nanosecond(),
+		time.UTC,
+	)
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+
+	return first.AddDate(0, 0, day-1)
+}
+
+// nextRunAt returns the date of the next occurrence of the template, which
+// is invalid once the schedule has ended.
+func nextRunAt(r *recurring.Recurring) sql.NullTime {
+	if r.Count.Valid && r.NextOccurrence >= r.Count.Int32 {
+		return sql.NullTime{}
+	}
+
+	at := occurrenceAt(r, r.NextOccurrence)
+	if r.EndDate.Valid && at.After(r.EndDate.Time) {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: at, Valid: true}
+}
+
+// advance moves the template to its next occurrence.
+func advance(r *recurring.Recurring) {
+	r.NextOccurrence++
+	r.NextRunAt = nextRunAt(r)
+}
+
+// due reports whether the next occurrence of the template is due at now.
+func due(r *recurring.Recurring, now time.Time) bool {
+	return r.NextRunAt.Valid && !r.NextRunAt.Time.After(now)
+}
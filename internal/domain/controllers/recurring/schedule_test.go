@@ -0,0 +1,185 @@
+package recurring
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"backend-master/internal/data/repositories/recurring"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestScheduleDate(t *testing.T) {
+	tests := []struct {
+		name       string
+		frequency  string
+		interval   int32
+		dayOfMonth int32 // unset when 0
+		start      time.Time
+		want       []time.Time // occurrences 0, 1, ...
+	}{
+		{
+			name:      "daily",
+			frequency: "DAILY",
+			interval:  3,
+			start:     date(2024, time.February, 27),
+			want:      []time.Time{date(2024, time.February, 27), date(2024, time.March, 1), date(2024, time.March, 4)},
+		},
+		{
+			name:      "weekly",
+			frequency: "WEEKLY",
+			interval:  2,
+			start:     date(2024, time.December, 20),
+			want:      []time.Time{date(2024, time.December, 20), date(2025, time.January, 3)},
+		},
+		{
+			name:      "month end is clamped, not carried over",
+			frequency: "MONTHLY",
+			interval:  1,
+			start:     date(2024, time.January, 31),
+			want: []time.Time{
+				date(2024, time.January, 31),
+				date(2024, time.February, 29),
+				date(2024, time.March, 31),
+				date(2024, time.April, 30),
+			},
+		},
+		{
+			name:      "month end in a common year",
+			frequency: "MONTHLY",
+			interval:  1,
+			start:     date(2023, time.January, 30),
+			want:      []time.Time{date(2023, time.January, 30), date(2023, time.February, 28), date(2023, time.March, 30)},
+		},
+		{
+			name:      "every second month",
+			frequency: "MONTHLY",
+			interval:  2,
+			start:     date(2024, time.December, 31),
+			want:      []time.Time{date(2024, time.December, 31), date(2025, time.February, 28), date(2025, time.April, 30)},
+		},
+		{
+			name:       "day of month before the start day",
+			frequency:  "MONTHLY",
+			interval:   1,
+			dayOfMonth: 5,
+			start:      date(2024, time.January, 20),
+			want:       []time.Time{date(2024, time.January, 5), date(2024, time.February, 5)},
+		},
+		{
+			name:       "day of month past the month end",
+			frequency:  "MONTHLY",
+			interval:   1,
+			dayOfMonth: 31,
+			start:      date(2024, time.April, 1),
+			want:       []time.Time{date(2024, time.April, 30), date(2024, time.May, 31), date(2024, time.June, 30)},
+		},
+		{
+			name:      "leap day yearly",
+			frequency: "YEARLY",
+			interval:  1,
+			start:     date(2024, time.February, 29),
+			want: []time.Time{
+				date(2024, time.February, 29),
+				date(2025, time.February, 28),
+				date(2026, time.February, 28),
+				date(2027, time.February, 28),
+				date(2028, time.February, 29),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recurring.Recurring{
+				Frequency: tt.frequency,
+				Interval:  tt.interval,
+				StartDate: tt.start,
+			}
+			if tt.dayOfMonth != 0 {
+				r.DayOfMonth = sql.NullInt32{Int32: tt.dayOfMonth, Valid: true}
+			}
+
+			for n, want := range tt.want {
+				if got := scheduleDate(r, int32(n)); !got.Equal(want) {
+					t.Errorf("scheduleDate(%d) = %s, want %s", n, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestOccurrenceAt(t *testing.T) {
+	r := &recurring.Recurring{
+		Frequency:  "MONTHLY",
+		Interval:   1,
+		DayOfMonth: sql.NullInt32{Int32: 5, Valid: true},
+		StartDate:  date(2024, time.January, 20),
+	}
+
+	// the 5th of January is before the start, so the schedule begins in
+	// February
+	want := []time.Time{date(2024, time.February, 5), date(2024, time.March, 5)}
+	for n, w := range want {
+		if got := occurrenceAt(r, int32(n)); !got.Equal(w) {
+			t.Errorf("occurrenceAt(%d) = %s, want %s", n, got, w)
+		}
+	}
+}
+
+func TestNextRunAt(t *testing.T) {
+	base := recurring.Recurring{
+		Frequency: "WEEKLY",
+		Interval:  1,
+		StartDate: date(2024, time.January, 1),
+	}
+
+	tests := []struct {
+		name string
+		edit func(r *recurring.Recurring)
+		want sql.NullTime
+	}{
+		{
+			name: "open ended",
+			edit: func(r *recurring.Recurring) { r.NextOccurrence = 2 },
+			want: sql.NullTime{Time: date(2024, time.January, 15), Valid: true},
+		},
+		{
+			name: "count reached",
+			edit: func(r *recurring.Recurring) {
+				r.Count = sql.NullInt32{Int32: 2, Valid: true}
+				r.NextOccurrence = 2
+			},
+		},
+		{
+			name: "last occurrence on the end date",
+			edit: func(r *recurring.Recurring) {
+				r.EndDate = sql.NullTime{Time: date(2024, time.January, 15), Valid: true}
+				r.NextOccurrence = 2
+			},
+			want: sql.NullTime{Time: date(2024, time.January, 15), Valid: true},
+		},
+		{
+			name: "past the end date",
+			edit: func(r *recurring.Recurring) {
+				r.EndDate = sql.NullTime{Time: date(2024, time.January, 14), Valid: true}
+				r.NextOccurrence = 2
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := base
+			tt.edit(&r)
+
+			got := nextRunAt(&r)
+			if got.Valid != tt.want.Valid || !got.Time.Equal(tt.want.Time) {
+				t.Errorf("nextRunAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
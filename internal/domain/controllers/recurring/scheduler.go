@@ -0,0 +1,203 @@
+package recurring
+
+import (
+	"backend-master/configs"
+	"backend-master/internal/data/repositories/recurring"
+	"backend-master/internal/data/repositories/wallet"
+	walletctrl "backend-master/internal/domain/controllers/wallet"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxCatchUp limits how many occurrences of a single template are created
+// in one transaction when the scheduler catches up after downtime. The rest
+// is created on the next claim of the template.
+const maxCatchUp = 100
+
+// Scheduler creates the due occurrences of recurring transactions. The
+// occurrence and the schedule position of its template are written in one
+// database transaction, and every occurrence is unique per template, so an
+// occurrence is created exactly once across restarts and instances.
+// A template whose occurrence fails is paused with the error.
+type Scheduler struct {
+	repo       recurring.RecurringRepository
+	walletCtrl walletctrl.WalletController
+	cfg        configs.RecurringConfig
+	logger     *zap.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(
+	repo recurring.RecurringRepository,
+	walletCtrl walletctrl.WalletController,
+	cfg configs.RecurringConfig,
+	logger *zap.Logger,
+) *Scheduler {
+	return &Scheduler{
+		repo:       repo,
+		walletCtrl: walletCtrl,
+		cfg:        cfg,
+		logger:     logger,
+	}
+}
+
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			s.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop waits for the template in flight to finish.
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+}
+
+// run processes due templates one at a time until none is left.
+func (s *Scheduler) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		more, err := s.processNext(ctx, time.Now())
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Error("failed to process recurring transactions", zap.Error(err))
+			}
+			return
+		}
+		if !more {
+			return
+		}
+	}
+}
+
+// processNext creates the due occurrences of one template. It returns false
+// when no template is due.
+func (s *Scheduler) processNext(ctx context.Context, now time.Time) (bool, error) {
+	var (
+		claimed *recurring.Recurring
+		failed  error
+	)
+
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		r, err := s.repo.ClaimDue(ctx, now)
+		if err != nil {
+			return err
+		}
+		claimed = r
+
+		for i := 0; i < maxCatchUp && due(r, now); i++ {
+			if err := s.createOccurrence(ctx, r); err != nil {
+				failed = err
+				return err
+			}
+			advance(r)
+		}
+
+		if _, err := s.repo.UpdateSchedule(ctx, r); err != nil {
+			return fmt.Errorf("failed to update recurring transaction in repository: %w", err)
+		}
+
+		return nil
+	})
+	switch {
+	case errors.Is(err, recurring.ErrRecurringNotFound) && claimed == nil:
+		return false, nil
+	case failed != nil && ctx.Err() == nil:
+		return true, s.pause(ctx, claimed, failed)
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *Scheduler) createOccurrence(ctx context.Context, r *recurring.Recurring) error {
+	row := walletctrl.ImportedTransaction{
+		Type:        wallet.TransactionDbTypeToPbType(r.Type),
+		Amount:      r.Amount,
+		Currency:    r.Currency,
+		Description: r.Description.String,
+		Date:        r.NextRunAt.Time,
+	}
+	if r.ToAccountID.Valid {
+		row.ToAccountID = r.ToAccountID.UUID.String()
+	}
+	if r.CategoryID.Valid {
+		row.CategoryID = r.CategoryID.UUID.String()
+	} else if r.MCC.Valid {
+		row.CategoryID = strconv.Itoa(int(r.MCC.Int32))
+	}
+
+	_, err := s.walletCtrl.CreateRecurringOccurrence(
+		ctx,
+		r.UserID,
+		r.ID,
+		r.NextOccurrence,
+		r.AccountID.String(),
+		row,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to create occurrence %d of recurring transaction %s: %w",
+			r.NextOccurrence,
+			r.ID.String(),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// pause stops a template whose occurrence could not be created, so it is
+// not retried on every tick. The user sees the error and resumes it.
+func (s *Scheduler) pause(ctx context.Context, r *recurring.Recurring, cause error) error {
+	s.logger.Warn(
+		"pausing recurring transaction",
+		zap.String("recurring_id", r.ID.String()),
+		zap.Error(cause),
+	)
+
+	return s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		locked, err := s.repo.GetRecurringForUpdate(ctx, r.UserID, r.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get recurring transaction from repository: %w", err)
+		}
+
+		locked.Paused = true
+		locked.LastError = sql.NullString{String: errors.Unwrap(cause).Error(), Valid: true}
+
+		if _, err := s.repo.UpdateSchedule(ctx, locked); err != nil {
+			return fmt.Errorf("failed to update recurring transaction in repository: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,579 @@
+package rule
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	masterpb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/database"
+	"backend-master/internal/data/repositories/rule"
+	"backend-master/internal/data/repositories/tag"
+	"backend-master/internal/data/repositories/wallet"
+	"backend-master/internal/domain/controllers/category"
+	tagctrl "backend-master/internal/domain/controllers/tag"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDryRunLimit = 100
+	maxDryRunLimit     = 1000
+
+	// scanBatchSize is how many transactions are loaded at once when rules
+	// are run over existing transactions
+	scanBatchSize = 500
+)
+
+var (
+	ErrEmptyRuleName     = errors.New("rule name must not be empty")
+	ErrRuleNoConditions  = errors.New("rule must have at least one condition")
+	ErrRuleNoActions     = errors.New("rule must set a category, a description or a tag")
+	ErrInvalidRegex      = errors.New("invalid description regex")
+	ErrInvalidAmountSpan = errors.New("rule minimum amount is greater than maximum amount")
+	ErrRuleAccount       = errors.New("rule account not found")
+	ErrReorderMismatch   = errors.New("reorder must list every rule of the user exactly once")
+)
+
+type RuleController interface {
+	ListRules(
+		ctx context.Context,
+		userID string,
+	) ([]*masterpb.TransactionRule, error)
+
+	CreateRule(
+		ctx context.Context,
+		userID string,
+		rule *masterpb.TransactionRule,
+	) (*masterpb.TransactionRule, error)
+
+	UpdateRule(
+		ctx context.Context,
+		userID string,
+		ruleID string,
+		rule *masterpb.TransactionRule,
+	) (*masterpb.TransactionRule, error)
+
+	DeleteRule(
+		ctx context.Context,
+		userID string,
+		ruleID string,
+	) error
+
+	// ReorderRules sets the evaluation order of the user's rules.
+	ReorderRules(
+		ctx context.Context,
+		userID string,
+		ruleIDs []string,
+	) ([]*masterpb.TransactionRule, error)
+
+	// DryRunRule lists existing transactions the rule would change without
+	// changing them. The rule does not need to be saved.
+	DryRunRule(
+		ctx context.Context,
+		userID string,
+		rule *masterpb.TransactionRule,
+		limit int32,
+	) (*DryRunResult, error)
+
+	// ApplyRules runs the user's enabled rules over all of their existing
+	// transactions and returns how many were changed.
+	ApplyRules(
+		ctx context.Context,
+		userID string,
+	) (int64, error)
+
+	// Apply runs the user's enabled rules over a new transaction. A category
+	// chosen explicitly for the transaction is kept and rule tags are added
+	// to tx.Tags for the caller to store with the transaction.
+	Apply(
+		ctx context.Context,
+		userID uuid.UUID,
+		tx *wallet.Transaction,
+	) error
+}
+
+type DryRunResult struct {
+	Changes   []*masterpb.RuleChange
+	Truncated bool
+}
+
+type ruleControllerImpl struct {
+	repo         rule.RuleRepository
+	walletRepo   wallet.WalletRepository
+	tagRepo      tag.TagRepository
+	categoryCtrl category.CategoryController
+	logger       *zap.Logger
+}
+
+func NewController(
+	repo rule.RuleRepository,
+	walletRepo wallet.WalletRepository,
+	tagRepo tag.TagRepository,
+	categoryCtrl category.CategoryController,
+	logger *zap.Logger,
+) RuleController {
+	return &ruleControllerImpl{
+		repo:         repo,
+		walletRepo:   walletRepo,
+		tagRepo:      tagRepo,
+		categoryCtrl: categoryCtrl,
+		logger:       logger,
+	}
+}
+
+func (cont *ruleControllerImpl) ListRules(
+	ctx context.Context,
+	userID string,
+) ([]*masterpb.TransactionRule, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	return cont.listRules(ctx, uid)
+}
+
+func (cont *ruleControllerImpl) CreateRule(
+	ctx context.Context,
+	userID string,
+	pbRule *masterpb.TransactionRule,
+) (*masterpb.TransactionRule, error) {
+	r, err := cont.newRule(ctx, userID, pbRule)
+	if err != nil {
+		return nil, err
+	}
+	r.CreatedAt = time.Now()
+
+	created, err := cont.repo.CreateRule(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rule in repository: %w", err)
+	}
+
+	return created.ToProto(), nil
+}
+
+func (cont *ruleControllerImpl) UpdateRule(
+	ctx context.Context,
+	userID string,
+	ruleID string,
+	pbRule *masterpb.TransactionRule,
+) (*masterpb.TransactionRule, error) {
+	rid, err := uuid.Parse(ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule ID: %w", err)
+	}
+
+	r, err := cont.newRule(ctx, userID, pbRule)
+	if err != nil {
+		return nil, err
+	}
+	r.ID = rid
+
+	updated, err := cont.repo.UpdateRule(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update rule in repository: %w", err)
+	}
+
+	return updated.ToProto(), nil
+}
+
+func (cont *ruleControllerImpl) DeleteRule(
+	ctx context.Context,
+	userID string,
+	ruleID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	rid, err := uuid.Parse(ruleID)
+	if err != nil {
+		return fmt.Errorf("invalid rule ID: %w", err)
+	}
+
+	if err := cont.repo.DeleteRule(ctx, uid, rid); err != nil {
+		return fmt.Errorf("failed to delete rule in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (cont *ruleControllerImpl) ReorderRules(
+	ctx context.Context,
+	userID string,
+	ruleIDs []string,
+) ([]*masterpb.TransactionRule, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(ruleIDs))
+	seen := make(map[uuid.UUID]struct{}, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		rid, err := uuid.Parse(ruleID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule ID %q: %w", ruleID, err)
+		}
+		if _, ok := seen[rid]; ok {
+			return nil, ErrReorderMismatch
+		}
+		seen[rid] = struct{}{}
+		ids = append(ids, rid)
+	}
+
+	rules, err := cont.repo.GetRules(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rules from repository: %w", err)
+	}
+
+	if len(rules) != len(ids) {
+		return nil, ErrReorderMismatch
+	}
+	for _, r := range rules {
+		if _, ok := seen[r.ID]; !ok {
+			return nil, ErrReorderMismatch
+		}
+	}
+
+	if err := cont.repo.ReorderRules(ctx, uid, ids); err != nil {
+		return nil, fmt.Errorf("failed to reorder rules in repository: %w", err)
+	}
+
+	return cont.listRules(ctx, uid)
+}
+
+func (cont *ruleControllerImpl) DryRunRule(
+	ctx context.Context,
+	userID string,
+	pbRule *masterpb.TransactionRule,
+	limit int32,
+) (*DryRunResult, error) {
+	r, err := cont.newRule(ctx, userID, pbRule)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := compile(r)
+	if err != nil {
+		return nil, err
+	}
+	rules := []*compiledRule{compiled}
+
+	switch {
+	case limit <= 0:
+		limit = defaultDryRunLimit
+	case limit > maxDryRunLimit:
+		limit = maxDryRunLimit
+	}
+
+	result := &DryRunResult{}
+	err = cont.scanTransactions(ctx, r.UserID, func(tx *wallet.Transaction) (bool, error) {
+		categoryID, description, tags, changed := evaluate(rules, tx).apply(tx)
+		if !changed {
+			return true, nil
+		}
+
+		if len(result.Changes) == int(limit) {
+			result.Truncated = true
+			return false, nil
+		}
+
+		change := &masterpb.RuleChange{
+			Transaction: tx.ToProto(),
+			Description: description.String,
+			Tags:        tags,
+		}
+		if categoryID.Valid {
+			change.CategoryId = categoryID.UUID.String()
+		}
+		result.Changes = append(result.Changes, change)
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cont *ruleControllerImpl) ApplyRules(
+	ctx context.Context,
+	userID string,
+) (int64, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	rules, err := cont.enabledRules(ctx, uid)
+	if err != nil {
+		return 0, err
+	}
+	if len(rules) == 0 {
+		return 0, nil
+	}
+
+	var updated int64
+	err = cont.scanTransactions(ctx, uid, func(tx *wallet.Transaction) (bool, error) {
+		categoryID, description, tags, changed := evaluate(rules, tx).apply(tx)
+		if !changed {
+			return true, nil
+		}
+
+		if categoryID != tx.CategoryID || description != tx.Description {
+			err := cont.walletRepo.UpdateTransactionDetails(ctx, tx.ID, categoryID, description)
+			if err != nil {
+				return false, fmt.Errorf("failed to update transaction in repository: %w", err)
+			}
+		}
+		if len(tags) > 0 {
+			if _, err := cont.tagRepo.AddTransactionTags(ctx, uid, tx.ID, tags); err != nil {
+				return false, fmt.Errorf("failed to tag transaction in repository: %w", err)
+			}
+		}
+
+		updated++
+		return true, nil
+	})
+	if err != nil {
+		return updated, err
+	}
+
+	return updated, nil
+}
+
+func (cont *ruleControllerImpl) Apply(
+	ctx context.Context,
+	userID uuid.UUID,
+	tx *wallet.Transaction,
+) error {
+	rules, err := cont.enabledRules(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	out := evaluate(rules, tx)
+	if tx.CategoryID.Valid {
+		out.categoryID = uuid.NullUUID{}
+	}
+
+	var tags []string
+	tx.CategoryID, tx.Description, tags, _ = out.apply(tx)
+	tx.Tags = append(tx.Tags, tags...)
+	return nil
+}
+
+func (cont *ruleControllerImpl) listRules(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*masterpb.TransactionRule, error) {
+	rules, err := cont.repo.GetRules(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rules from repository: %w", err)
+	}
+
+	pbRules := make([]*masterpb.TransactionRule, 0, len(rules))
+	for _, r := range rules {
+		pbRules = append(pbRules, r.ToProto())
+	}
+
+	return pbRules, nil
+}
+
+func (cont *ruleControllerImpl) enabledRules(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*compiledRule, error) {
+	rules, err := cont.repo.GetRules(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rules from repository: %w", err)
+	}
+
+	compiled := make([]*compiledRule, 0, len(rules))
+	for i := range rules {
+		if !rules[i].Enabled {
+			continue
+		}
+
+		c, err := compile(&rules[i])
+		if err != nil {
+			// regexes are validated on save, so this only skips rules
+			// saved before a validation change
+			cont.logger.Warn(
+				"skipping invalid rule",
+				zap.String("rule_id", rules[i].ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+
+	return compiled, nil
+}
+
+// scanTransactions calls fn for every transaction of the user, with its
+// tags, newest first, until fn returns false.
+func (cont *ruleControllerImpl) scanTransactions(
+	ctx context.Context,
+	userID uuid.UUID,
+	fn func(tx *wallet.Transaction) (bool, error),
+) error {
+	filter := wallet.TransactionFilter{
+		UserID: userID,
+		Limit:  scanBatchSize,
+	}
+
+	for {
+		transactions, err := cont.walletRepo.GetTransactions(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to get transactions from repository: %w", err)
+		}
+
+		ids := make([]uuid.UUID, 0, len(transactions))
+		for _, tx := range transactions {
+			ids = append(ids, tx.ID)
+		}
+		tags, err := cont.walletRepo.GetTransactionTags(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("failed to get transaction tags from repository: %w", err)
+		}
+		for i := range transactions {
+			transactions[i].Tags = tags[transactions[i].ID]
+		}
+
+		for i := range transactions {
+			more, err := fn(&transactions[i])
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		if len(transactions) < scanBatchSize {
+			return nil
+		}
+
+		last := transactions[len(transactions)-1]
+		filter.After = &database.Cursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+}
+
+func (cont *ruleControllerImpl) newRule(
+	ctx context.Context,
+	userID string,
+	pbRule *masterpb.TransactionRule,
+) (*rule.Rule, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if pbRule == nil {
+		return nil, ErrRuleNoConditions
+	}
+
+	r := &rule.Rule{
+		UserID:              uid,
+		Name:                strings.TrimSpace(pbRule.Name),
+		Enabled:             pbRule.Enabled == nil || *pbRule.Enabled,
+		DescriptionContains: optionalString(pbRule.DescriptionContains),
+		DescriptionRegex:    optionalString(pbRule.DescriptionRegex),
+		SetDescription:      optionalString(pbRule.SetDescription),
+		SetTag:              optionalString(pbRule.SetTag),
+	}
+
+	if r.Name == "" {
+		return nil, ErrEmptyRuleName
+	}
+
+	if pbRule.MinAmount != nil {
+		r.MinAmount = sql.NullInt64{Int64: *pbRule.MinAmount, Valid: true}
+	}
+	if pbRule.MaxAmount != nil {
+		r.MaxAmount = sql.NullInt64{Int64: *pbRule.MaxAmount, Valid: true}
+	}
+	if r.MinAmount.Valid && r.MaxAmount.Valid && r.MinAmount.Int64 > r.MaxAmount.Int64 {
+		return nil, ErrInvalidAmountSpan
+	}
+
+	if pbRule.Mcc != nil {
+		r.MCC = sql.NullInt32{Int32: *pbRule.Mcc, Valid: true}
+	}
+
+	if pbRule.AccountId != "" {
+		aid, err := uuid.Parse(pbRule.AccountId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account ID: %w", err)
+		}
+
+		accounts, err := cont.walletRepo.GetAccountsByUserID(ctx, uid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get accounts from repository: %w", err)
+		}
+
+		found := false
+		for _, acc := range accounts {
+			found = found || acc.ID == aid
+		}
+		if !found {
+			return nil, ErrRuleAccount
+		}
+
+		r.AccountID = uuid.NullUUID{UUID: aid, Valid: true}
+	}
+
+	if pbRule.SetCategoryId != "" {
+		cid, err := uuid.Parse(pbRule.SetCategoryId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid category ID: %w", err)
+		}
+
+		if _, err := cont.categoryCtrl.GetCategory(ctx, uid, cid); err != nil {
+			return nil, fmt.Errorf("failed to check rule category: %w", err)
+		}
+
+		r.SetCategoryID = uuid.NullUUID{UUID: cid, Valid: true}
+	}
+
+	if r.SetTag.Valid {
+		if err := tagctrl.CheckTagName(r.SetTag.String); err != nil {
+			return nil, err
+		}
+	}
+
+	hasCondition := r.DescriptionContains.Valid ||
+		r.DescriptionRegex.Valid ||
+		r.MinAmount.Valid ||
+		r.MaxAmount.Valid ||
+		r.AccountID.Valid ||
+		r.MCC.Valid
+	if !hasCondition {
+		return nil, ErrRuleNoConditions
+	}
+
+	if !r.SetCategoryID.Valid && !r.SetDescription.Valid && !r.SetTag.Valid {
+		return nil, ErrRuleNoActions
+	}
+
+	if _, err := compile(r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func optionalString(s string) sql.NullString {
+	s = strings.TrimSpace(s)
+	return sql.NullString{String: s, Valid: s != ""}
+}
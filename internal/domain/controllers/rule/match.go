@@ -0,0 +1,118 @@
+package rule
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"backend-master/internal/data/repositories/rule"
+	"backend-master/internal/data/repositories/wallet"
+
+	"github.com/google/uuid"
+)
+
+type compiledRule struct {
+	*rule.Rule
+	re *regexp.Regexp
+}
+
+func compile(r *rule.Rule) (*compiledRule, error) {
+	compiled := &compiledRule{Rule: r}
+
+	if r.DescriptionRegex.Valid {
+		re, err := regexp.Compile(r.DescriptionRegex.String)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRegex, err)
+		}
+		compiled.re = re
+	}
+
+	return compiled, nil
+}
+
+func (r *compiledRule) matches(tx *wallet.Transaction) bool {
+	description := tx.Description.String
+
+	if r.DescriptionContains.Valid &&
+		!strings.Contains(strings.ToLower(description), strings.ToLower(r.DescriptionContains.String)) {
+		return false
+	}
+	if r.re != nil && !r.re.MatchString(description) {
+		return false
+	}
+	if r.MinAmount.Valid && tx.Amount < r.MinAmount.Int64 {
+		return false
+	}
+	if r.MaxAmount.Valid && tx.Amount > r.MaxAmount.Int64 {
+		return false
+	}
+	if r.AccountID.Valid && tx.AccountID != r.AccountID.UUID {
+		return false
+	}
+	if r.MCC.Valid && (!tx.MCC.Valid || tx.MCC.Int32 != r.MCC.Int32) {
+		return false
+	}
+
+	return true
+}
+
+// outcome is what the rules set on a transaction. Unset fields are left as
+// they are and tags are added to the ones the transaction has.
+type outcome struct {
+	categoryID  uuid.NullUUID
+	description sql.NullString
+	tags        []string
+}
+
+// evaluate runs the rules in order against the transaction. Once a rule has
+// set a category or description, later rules do not change it; the tags of
+// every matching rule are added.
+func evaluate(rules []*compiledRule, tx *wallet.Transaction) outcome {
+	var out outcome
+	for _, r := range rules {
+		if !r.matches(tx) {
+			continue
+		}
+
+		if !out.categoryID.Valid && r.SetCategoryID.Valid {
+			out.categoryID = r.SetCategoryID
+		}
+		if !out.description.Valid && r.SetDescription.Valid {
+			out.description = r.SetDescription
+		}
+		if r.SetTag.Valid {
+			out.tags = append(out.tags, r.SetTag.String)
+		}
+	}
+	return out
+}
+
+// apply returns the category and description the transaction gets from the
+// outcome and the tags it does not have yet, and reports whether anything
+// changes. Tags are compared regardless of case.
+func (out outcome) apply(tx *wallet.Transaction) (uuid.NullUUID, sql.NullString, []string, bool) {
+	categoryID, description := tx.CategoryID, tx.Description
+	if out.categoryID.Valid {
+		categoryID = out.categoryID
+	}
+	if out.description.Valid {
+		description = out.description
+	}
+
+	seen := make(map[string]struct{}, len(tx.Tags)+len(out.tags))
+	for _, t := range tx.Tags {
+		seen[strings.ToLower(t)] = struct{}{}
+	}
+	var tags []string
+	for _, t := range out.tags {
+		if _, ok := seen[strings.ToLower(t)]; ok {
+			continue
+		}
+		seen[strings.ToLower(t)] = struct{}{}
+		tags = append(tags, t)
+	}
+
+	changed := categoryID != tx.CategoryID || description != tx.Description || len(tags) > 0
+	return categoryID, description, tags, changed
+}
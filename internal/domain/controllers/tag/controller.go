@@ -0,0 +1,215 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	masterpb "backend-master/internal/api-gen/proto/master"
+	"backend-master/internal/data/repositories/tag"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const maxTagLength = 64
+
+var (
+	ErrNoTags         = errors.New("at least one tag must be given")
+	ErrEmptyTagName   = errors.New("tag name must not be empty")
+	ErrTagNameTooLong = errors.New("tag name is too long")
+	ErrInvalidPeriod  = errors.New("end date is before the start date")
+)
+
+type TagController interface {
+	ListTags(
+		ctx context.Context,
+		userID string,
+	) ([]*masterpb.Tag, error)
+
+	// AddTags tags a transaction, creating tags the user does not have yet,
+	// and returns all tags of the transaction. Tag names are matched
+	// regardless of case.
+	AddTags(
+		ctx context.Context,
+		userID string,
+		transactionID string,
+		names []string,
+	) ([]string, error)
+
+	// RemoveTags removes tags from a transaction and returns the remaining
+	// ones.
+	RemoveTags(
+		ctx context.Context,
+		userID string,
+		transactionID string,
+		names []string,
+	) ([]string, error)
+
+	// GetTagSummary totals tagged income and expenses made between the dates
+	// per tag. A tag used in several currencies has a summary per currency.
+	GetTagSummary(
+		ctx context.Context,
+		userID string,
+		startDate time.Time,
+		endDate time.Time,
+	) ([]*masterpb.TagSummary, error)
+}
+
+type tagControllerImpl struct {
+	repo   tag.TagRepository
+	logger *zap.Logger
+}
+
+func NewController(
+	repo tag.TagRepository,
+	logger *zap.Logger,
+) TagController {
+	return &tagControllerImpl{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (cont *tagControllerImpl) ListTags(
+	ctx context.Context,
+	userID string,
+) ([]*masterpb.Tag, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tags, err := cont.repo.GetTags(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags from repository: %w", err)
+	}
+
+	pbTags := make([]*masterpb.Tag, 0, len(tags))
+	for _, t := range tags {
+		pbTags = append(pbTags, t.ToProto())
+	}
+
+	return pbTags, nil
+}
+
+func (cont *tagControllerImpl) AddTags(
+	ctx context.Context,
+	userID string,
+	transactionID string,
+	names []string,
+) ([]string, error) {
+	uid, tid, names, err := parseTagging(userID, transactionID, names)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := cont.repo.AddTransactionTags(ctx, uid, tid, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add tags in repository: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (cont *tagControllerImpl) RemoveTags(
+	ctx context.Context,
+	userID string,
+	transactionID string,
+	names []string,
+) ([]string, error) {
+	uid, tid, names, err := parseTagging(userID, transactionID, names)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := cont.repo.RemoveTransactionTags(ctx, uid, tid, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove tags in repository: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (cont *tagControllerImpl) GetTagSummary(
+	ctx context.Context,
+	userID string,
+	startDate time.Time,
+	endDate time.Time,
+) ([]*masterpb.TagSummary, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if !startDate.IsZero() && !endDate.IsZero() && endDate.Before(startDate) {
+		return nil, ErrInvalidPeriod
+	}
+
+	summaries, err := cont.repo.GetSummary(ctx, uid, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag summary from repository: %w", err)
+	}
+
+	pbSummaries := make([]*masterpb.TagSummary, 0, len(summaries))
+	for _, s := range summaries {
+		pbSummaries = append(pbSummaries, s.ToProto())
+	}
+
+	return pbSummaries, nil
+}
+
+// parseTagging parses the IDs and trims the tag names, dropping names that
+// differ only in case.
+func parseTagging(
+	userID string,
+	transactionID string,
+	names []string,
+) (uuid.UUID, uuid.UUID, []string, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tid, err := uuid.Parse(transactionID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	if len(names) == 0 {
+		return uuid.Nil, uuid.Nil, nil, ErrNoTags
+	}
+
+	unique := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if err := CheckTagName(name); err != nil {
+			return uuid.Nil, uuid.Nil, nil, err
+		}
+
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, name)
+	}
+
+	return uid, tid, unique, nil
+}
+
+// CheckTagName checks a trimmed tag name, e.g. one a rule tags transactions
+// with.
+func CheckTagName(name string) error {
+	if name == "" {
+		return ErrEmptyTagName
+	}
+	if utf8.RuneCountInString(name) > maxTagLength {
+		return fmt.Errorf("%w: %q", ErrTagNameTooLong, name)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	pb "backend-master/internal/api-gen/proto/wallet"
+	"backend-master/internal/data/repositories/wallet"
+	currencyController "backend-master/internal/domain/controllers/currency"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyAccountName       = errors.New("account name must not be empty")
+	ErrAccountHasTransactions = errors.New("account has transactions, reassign them before deleting")
+	ErrReassignToSameAccount  = errors.New("transactions cannot be reassigned to the deleted account")
+	ErrReassignCurrency       = errors.New("transactions can only be reassigned to an account with the same currency")
+	ErrReassignLinkedTransfer = errors.New("account has transfers with the reassignment target")
+)
+
+func (cont *walletControllerImpl) CreateAccount(
+	ctx context.Context,
+	userID string,
+	name string,
+	accType common.AccountType,
+	initialBalance int64,
+	currency string,
+) (*pb.Account, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyAccountName
+	}
+
+	currency, err = currencyController.NormalizeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := cont.repo.CreateAccount(
+		ctx,
+		&wallet.Account{
+			UserID:    uid,
+			Name:      name,
+			Type:      wallet.AccountPbTypeToDbType(accType),
+			Balance:   initialBalance,
+			Currency:  currency,
+			CreatedAt: time.Now(),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account in repository: %w", err)
+	}
+
+	return acc.ToProto(), nil
+}
+
+func (cont *walletControllerImpl) UpdateAccount(
+	ctx context.Context,
+	userID string,
+	accountID string,
+	name string,
+) (*pb.Account, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyAccountName
+	}
+
+	var updated *wallet.Account
+	err := cont.withOwnedAccount(ctx, userID, accountID, func(ctx context.Context, repo wallet.WalletRepository, acc *wallet.Account) error {
+		var err error
+		updated, err = repo.UpdateAccountName(ctx, acc.ID, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated.ToProto(), nil
+}
+
+func (cont *walletControllerImpl) ArchiveAccount(
+	ctx context.Context,
+	userID string,
+	accountID string,
+	archived bool,
+) (*pb.Account, error) {
+	var updated *wallet.Account
+	err := cont.withOwnedAccount(ctx, userID, accountID, func(ctx context.Context, repo wallet.WalletRepository, acc *wallet.Account) error {
+		var err error
+		updated, err = repo.SetAccountArchived(ctx, acc.ID, archived)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated.ToProto(), nil
+}
+
+func (cont *walletControllerImpl) DeleteAccount(
+	ctx context.Context,
+	userID string,
+	accountID string,
+	reassignToAccountID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	aid, err := uuid.Parse(accountID)
+	if err != nil {
+		return fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	var targetAid uuid.UUID
+	if reassignToAccountID != "" {
+		targetAid, err = uuid.Parse(reassignToAccountID)
+		if err != nil {
+			return fmt.Errorf("invalid reassignment account ID: %w", err)
+		}
+		if targetAid == aid {
+			return ErrReassignToSameAccount
+		}
+	}
+
+	return cont.repo.WithinTx(ctx, func(ctx context.Context, repo wallet.WalletRepository) error {
+		lockIDs := []uuid.UUID{aid}
+		if targetAid != uuid.Nil {
+			lockIDs = append(lockIDs, targetAid)
+		}
+
+		accounts, err := repo.LockAccounts(ctx, lockIDs...)
+		if err != nil {
+			return fmt.Errorf("failed to lock accounts: %w", err)
+		}
+		if err := checkOwnership(accounts, uid); err != nil {
+			return err
+		}
+
+		count, err := repo.CountAccountTransactions(ctx, aid)
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			if targetAid == uuid.Nil {
+				return ErrAccountHasTransactions
+			}
+
+			if err := cont.reassignTransactions(ctx, repo, accounts, aid, targetAid); err != nil {
+				return err
+			}
+		}
+
+		if err := repo.DeleteAccount(ctx, aid); err != nil {
+			return fmt.Errorf("failed to delete account in repository: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// reassignTransactions moves the transactions of the deleted account to the
+// target and carries their balance effect over with them.
+func (cont *walletControllerImpl) reassignTransactions(
+	ctx context.Context,
+	repo wallet.WalletRepository,
+	accounts []wallet.Account,
+	accountID uuid.UUID,
+	targetAccountID uuid.UUID,
+) error {
+	currencies := map[uuid.UUID]string{}
+	for _, acc := range accounts {
+		currencies[acc.ID] = acc.Currency
+	}
+	if currencies[accountID] != currencies[targetAccountID] {
+		return ErrReassignCurrency
+	}
+
+	linked, err := repo.CountTransfersBetween(ctx, accountID, targetAccountID)
+	if err != nil {
+		return err
+	}
+	if linked > 0 {
+		return ErrReassignLinkedTransfer
+	}
+
+	effect, err := repo.SumTransactionsEffect(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.ReassignTransactions(ctx, accountID, targetAccountID); err != nil {
+		return err
+	}
+
+	return balanceChangeSet{targetAccountID: effect}.apply(ctx, repo)
+}
+
+// withOwnedAccount locks the account, checks that it belongs to the user and
+// runs fn in the same database transaction.
+func (cont *walletControllerImpl) withOwnedAccount(
+	ctx context.Context,
+	userID string,
+	accountID string,
+	fn func(ctx context.Context, repo wallet.WalletRepository, acc *wallet.Account) error,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	aid, err := uuid.Parse(accountID)
+	if err != nil {
+		return fmt.Errorf("invalid account ID: %w", err)
+	}
+
+	return cont.repo.WithinTx(ctx, func(ctx context.Context, repo wallet.WalletRepository) error {
+		accounts, err := repo.LockAccounts(ctx, aid)
+		if err != nil {
+			return fmt.Errorf("failed to lock account: %w", err)
+		}
+		if err := checkOwnership(accounts, uid); err != nil {
+			return err
+		}
+
+		return fn(ctx, repo, &accounts[0])
+	})
+}
@@ -0,0 +1,48 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"backend-master/internal/data/repositories/wallet"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// attachmentKeys returns the storage keys of the files attached to the
+// transactions. The rows go away with the transactions, so the keys are
+// loaded before deleting them.
+func attachmentKeys(
+	ctx context.Context,
+	repo wallet.WalletRepository,
+	transactionIDs ...uuid.UUID,
+) ([]string, error) {
+	attachments, err := repo.GetAttachments(ctx, transactionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments from repository: %w", err)
+	}
+
+	var keys []string
+	for _, id := range transactionIDs {
+		for _, attachment := range attachments[id] {
+			keys = append(keys, attachment.StorageKey)
+		}
+	}
+
+	return keys, nil
+}
+
+// deleteBlobs removes the content of attachments whose rows are deleted
+// already. A leftover blob only takes space, so failures are just logged.
+func (cont *walletControllerImpl) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := cont.blobs.Delete(ctx, key); err != nil {
+			cont.logger.Error(
+				"failed to delete attachment content",
+				zap.String("key", key),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -3,13 +3,19 @@ package wallet
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"strconv"
 	"time"
 
 	"backend-master/internal/api-gen/proto/common"
 	pb "backend-master/internal/api-gen/proto/wallet"
+	"backend-master/internal/data/database"
+	"backend-master/internal/data/repositories/tag"
 	"backend-master/internal/data/repositories/wallet"
+	"backend-master/internal/data/storage"
+	"backend-master/internal/domain/controllers/budget"
+	"backend-master/internal/domain/controllers/category"
+	"backend-master/internal/domain/controllers/rule"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -21,39 +27,168 @@ type WalletController interface {
 		userID string,
 	) (*pb.GetAccountsResponse, error)
 
+	CreateAccount(
+		ctx context.Context,
+		userID string,
+		name string,
+		accType common.AccountType,
+		initialBalance int64,
+		currency string,
+	) (*pb.Account, error)
+
+	UpdateAccount(
+		ctx context.Context,
+		userID string,
+		accountID string,
+		name string,
+	) (*pb.Account, error)
+
+	ArchiveAccount(
+		ctx context.Context,
+		userID string,
+		accountID string,
+		archived bool,
+	) (*pb.Account, error)
+
+	// DeleteAccount refuses to delete an account that still has transactions
+	// unless reassignToAccountID names an account to move them to.
+	DeleteAccount(
+		ctx context.Context,
+		userID string,
+		accountID string,
+		reassignToAccountID string,
+	) error
+
 	GetUserTransactions(
 		ctx context.Context,
-		userId string,
-	) (*pb.GetTransactionsResponse, error)
+		query TransactionsQuery,
+	) (*TransactionsPage, error)
 
+	// CreateTransaction books a transaction in one of the user's accounts. A
+	// transfer is booked as two legs, one in each account, and toAmount is
+	// what the target account receives in its currency; it may be left zero
+	// when both accounts share the currency.
 	CreateTransaction(
 		ctx context.Context,
+		userID string,
 		accountID string,
 		toAccountID string,
 		txType common.TransactionType,
 		amount int64,
 		currency string,
-		mcc string,
+		toAmount int64,
+		toCurrency string,
+		categoryID string,
 		description string,
 		date time.Time,
 	) (*pb.Transaction, error)
+
+	// ImportTransaction creates a transaction from a bank statement row in one
+	// of the user's accounts.
+	ImportTransaction(
+		ctx context.Context,
+		userID string,
+		accountID string,
+		row ImportedTransaction,
+	) (*pb.Transaction, error)
+
+	// CreateRecurringOccurrence creates the given occurrence of a recurring
+	// transaction template. Every occurrence is created at most once, a
+	// repeated one fails with wallet.ErrTransactionExists.
+	CreateRecurringOccurrence(
+		ctx context.Context,
+		userID uuid.UUID,
+		recurringID uuid.UUID,
+		occurrence int32,
+		accountID string,
+		row ImportedTransaction,
+	) (*pb.Transaction, error)
+
+	// UpdateTransaction replaces a transaction, taking the same arguments as
+	// CreateTransaction. Either leg of a transfer can be given, both are
+	// changed.
+	UpdateTransaction(
+		ctx context.Context,
+		userID string,
+		transactionID string,
+		accountID string,
+		toAccountID string,
+		txType common.TransactionType,
+		amount int64,
+		currency string,
+		toAmount int64,
+		toCurrency string,
+		categoryID string,
+		description string,
+		date time.Time,
+	) (*pb.Transaction, error)
+
+	// DeleteTransaction deletes a transaction, both legs of a transfer.
+	DeleteTransaction(
+		ctx context.Context,
+		userID string,
+		transactionID string,
+	) error
+
+	// SetTransactionSplits splits a transaction across categories. The split
+	// amounts must add up to the transaction amount, no splits remove the
+	// split. Account balances are not affected.
+	SetTransactionSplits(
+		ctx context.Context,
+		userID string,
+		transactionID string,
+		splits []*pb.TransactionSplit,
+	) (*pb.Transaction, error)
+}
+
+// ImportedTransaction is a bank statement row to be stored as a transaction.
+type ImportedTransaction struct {
+	Type common.TransactionType
+	// ToAccountID is the target of a transfer out of the account,
+	// FromAccountID the source of a transfer into it
+	ToAccountID   string
+	FromAccountID string
+	Amount        int64
+	Currency      string
+	CategoryID    string
+	Description   string
+	Date          time.Time
+	// Fingerprint identifies the statement row so it is not imported twice
+	Fingerprint string
+	// ExternalID is the bank's own ID of the row, such as an OFX FITID
+	ExternalID string
 }
 
 type walletControllerImpl struct {
-	repo   wallet.WalletRepository
-	client *wallet.WalletClient
-	logger *zap.Logger
+	repo       wallet.WalletRepository
+	client     *wallet.WalletClient
+	budgets    budget.BudgetController
+	categories category.CategoryController
+	rules      rule.RuleController
+	tags       tag.TagRepository
+	blobs      storage.BlobStorage
+	logger     *zap.Logger
 }
 
 func NewController(
 	repo wallet.WalletRepository,
 	client *wallet.WalletClient,
+	budgets budget.BudgetController,
+	categories category.CategoryController,
+	rules rule.RuleController,
+	tags tag.TagRepository,
+	blobs storage.BlobStorage,
 	logger *zap.Logger,
 ) WalletController {
 	return &walletControllerImpl{
-		repo:   repo,
-		client: client,
-		logger: logger,
+		repo:       repo,
+		client:     client,
+		budgets:    budgets,
+		categories: categories,
+		rules:      rules,
+		tags:       tags,
+		blobs:      blobs,
+		logger:     logger,
 	}
 }
 
@@ -86,57 +221,466 @@ func (cont *walletControllerImpl) GetUserAccounts(
 
 func (cont *walletControllerImpl) GetUserTransactions(
 	ctx context.Context,
-	userId string,
-) (*pb.GetTransactionsResponse, error) {
-	uid, err := uuid.Parse(userId)
+	query TransactionsQuery,
+) (*TransactionsPage, error) {
+	filter, err := query.toFilter()
 	if err != nil {
-		return nil, fmt.Errorf("invalid account ID: %w", err)
+		return nil, err
 	}
 
-	accounts, err := cont.repo.GetAccountsByUserID(ctx, uid)
+	// one extra row tells whether there is a next page
+	pageSize := filter.Limit
+	filter.Limit++
+
+	transactions, err := cont.repo.GetTransactions(ctx, *filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions from repository: %w", err)
+	}
 
-	transactions := make([]wallet.Transaction, 16)
+	page := &TransactionsPage{}
+	if len(transactions) > pageSize {
+		transactions = transactions[:pageSize]
 
-	for _, acc := range accounts {
-		accTransactions, err := cont.repo.GetTransactionsByAccountID(ctx, acc.ID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get transactions from repository: %w", err)
-		}
+		last := transactions[len(transactions)-1]
+		page.NextPageToken = database.Cursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}.Encode()
+	}
 
-		transactions = append(transactions, accTransactions...)
+	if err := cont.loadDetails(ctx, transactions); err != nil {
+		return nil, err
 	}
 
-	pbTransactions := make([]*pb.Transaction, 0, len(transactions))
+	page.Transactions = make([]*pb.Transaction, 0, len(transactions))
 	for _, tx := range transactions {
-		pbTx := tx.ToProto()
+		page.Transactions = append(page.Transactions, tx.ToProto())
+	}
+
+	return page, nil
+}
 
-		if tx.ToAccountID.Valid {
-			pbTx.ToAccountId = tx.ToAccountID.String
+func (cont *walletControllerImpl) CreateTransaction(
+	ctx context.Context,
+	userID string,
+	accountID string,
+	toAccountID string,
+	txType common.TransactionType,
+	amount int64,
+	currency string,
+	toAmount int64,
+	toCurrency string,
+	categoryID string,
+	description string,
+	date time.Time,
+) (*pb.Transaction, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tx, err := newTransaction(
+		accountID,
+		toAccountID,
+		txType,
+		amount,
+		currency,
+		categoryID,
+		description,
+		date,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	counter := counterAmount{Amount: toAmount, Currency: toCurrency}
+	created, err := cont.createTransaction(ctx, tx, counter, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return created.ToProto(), nil
+}
+
+func (cont *walletControllerImpl) ImportTransaction(
+	ctx context.Context,
+	userID string,
+	accountID string,
+	row ImportedTransaction,
+) (*pb.Transaction, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tx, err := newImportedTransaction(accountID, row)
+	if err != nil {
+		return nil, err
+	}
+	tx.ImportFingerprint = sql.NullString{String: row.Fingerprint, Valid: row.Fingerprint != ""}
+	tx.ExternalID = sql.NullString{String: row.ExternalID, Valid: row.ExternalID != ""}
+
+	created, err := cont.createTransaction(ctx, tx, counterAmount{}, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return created.ToProto(), nil
+}
+
+func (cont *walletControllerImpl) CreateRecurringOccurrence(
+	ctx context.Context,
+	userID uuid.UUID,
+	recurringID uuid.UUID,
+	occurrence int32,
+	accountID string,
+	row ImportedTransaction,
+) (*pb.Transaction, error) {
+	tx, err := newImportedTransaction(accountID, row)
+	if err != nil {
+		return nil, err
+	}
+	tx.RecurringID = uuid.NullUUID{UUID: recurringID, Valid: true}
+	tx.Occurrence = sql.NullInt32{Int32: occurrence, Valid: true}
+
+	created, err := cont.createTransaction(ctx, tx, counterAmount{}, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return created.ToProto(), nil
+}
+
+// createTransaction stores tx, together with the other leg of a transfer,
+// and applies them to account balances. Every account touched must belong
+// to userID.
+func (cont *walletControllerImpl) createTransaction(
+	ctx context.Context,
+	tx *wallet.Transaction,
+	counter counterAmount,
+	userID uuid.UUID,
+) (*wallet.Transaction, error) {
+	var createdTx *wallet.Transaction
+	err := cont.repo.WithinTx(ctx, func(ctx context.Context, repo wallet.WalletRepository) error {
+		accounts, err := repo.LockAccounts(ctx, touchedAccountIDs(tx)...)
+		if err != nil {
+			return fmt.Errorf("failed to lock accounts: %w", err)
+		}
+
+		if err := checkOwnership(accounts, userID); err != nil {
+			return err
+		}
+		if err := checkNotArchived(accounts); err != nil {
+			return err
+		}
+
+		if err := cont.rules.Apply(ctx, userID, tx); err != nil {
+			return fmt.Errorf("failed to apply rules: %w", err)
+		}
+		if err := cont.checkCategory(ctx, userID, tx); err != nil {
+			return err
+		}
+
+		var leg *wallet.Transaction
+		if tx.Type == "TRANSFER" {
+			tx.TransferID = uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+			leg, err = transferLeg(tx, counter, findAccount(accounts, otherAccountID(tx)))
+			if err != nil {
+				return err
+			}
+		}
+
+		created, err := repo.CreateTransaction(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to create transaction in repository: %w", err)
+		}
+		if leg != nil {
+			created.Counterpart, err = repo.CreateTransaction(ctx, leg)
+			if err != nil {
+				return fmt.Errorf("failed to create transfer leg in repository: %w", err)
+			}
+		}
+		if len(tx.Tags) > 0 {
+			created.Tags, err = cont.tags.AddTransactionTags(ctx, userID, created.ID, tx.Tags)
+			if err != nil {
+				return fmt.Errorf("failed to tag transaction in repository: %w", err)
+			}
 		}
 
-		if tx.Description.Valid {
-			pbTx.Description = tx.Description.String
+		if err := balanceChanges(created, leg).apply(ctx, repo); err != nil {
+			return err
 		}
 
-		pbTransactions = append(pbTransactions, pbTx)
+		if created.Type == "EXPENSE" {
+			err := cont.budgets.CheckThresholds(
+				ctx,
+				userID,
+				created.MCC,
+				created.CategoryID,
+				created.Currency,
+				created.CreatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to check budgets: %w", err)
+			}
+		}
+
+		createdTx = created
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.GetTransactionsResponse{
-		Transactions: pbTransactions,
-	}, nil
+	return createdTx, nil
 }
 
-func (cont *walletControllerImpl) CreateTransaction(
+func (cont *walletControllerImpl) UpdateTransaction(
 	ctx context.Context,
+	userID string,
+	transactionID string,
 	accountID string,
 	toAccountID string,
 	txType common.TransactionType,
 	amount int64,
 	currency string,
-	mcc string,
+	toAmount int64,
+	toCurrency string,
+	categoryID string,
 	description string,
 	date time.Time,
 ) (*pb.Transaction, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tid, err := uuid.Parse(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	tx, err := newTransaction(
+		accountID,
+		toAccountID,
+		txType,
+		amount,
+		currency,
+		categoryID,
+		description,
+		date,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tx.ID = tid
+	counter := counterAmount{Amount: toAmount, Currency: toCurrency}
+
+	var (
+		updatedTx   *wallet.Transaction
+		deletedKeys []string
+	)
+	err = cont.repo.WithinTx(ctx, func(ctx context.Context, repo wallet.WalletRepository) error {
+		oldTx, err := repo.GetTransactionForUpdate(ctx, tid)
+		if err != nil {
+			return err
+		}
+		oldLeg, err := transferLegForUpdate(ctx, repo, oldTx)
+		if err != nil {
+			return err
+		}
+
+		// splits are kept, so they must still fit the changed transaction
+		splits, err := repo.GetSplits(ctx, []uuid.UUID{tid})
+		if err != nil {
+			return fmt.Errorf("failed to get splits from repository: %w", err)
+		}
+		tx.Splits = splits[tid]
+		if err := checkSplits(tx, tx.Splits); err != nil {
+			return err
+		}
+
+		lockIDs := append(legAccountIDs(oldTx, oldLeg), touchedAccountIDs(tx)...)
+		accounts, err := repo.LockAccounts(ctx, lockIDs...)
+		if err != nil {
+			return fmt.Errorf("failed to lock accounts: %w", err)
+		}
+		if err := checkOwnership(accounts, uid); err != nil {
+			return err
+		}
+		if err := checkNotArchived(accounts); err != nil {
+			return err
+		}
+		if err := cont.checkCategory(ctx, uid, tx); err != nil {
+			return err
+		}
+
+		var leg *wallet.Transaction
+		if tx.Type == "TRANSFER" {
+			tx.TransferID = uuid.NullUUID{UUID: uuid.New(), Valid: true}
+			if oldLeg != nil {
+				tx.TransferID = oldTx.TransferID
+			}
+
+			leg, err = transferLeg(tx, counter, findAccount(accounts, otherAccountID(tx)))
+			if err != nil {
+				return err
+			}
+
+			// the legs keep their rows, so the edited one may become the
+			// incoming leg
+			if oldLeg != nil {
+				outID, inID := oldTx.ID, oldLeg.ID
+				if !oldTx.IsOutgoingTransfer() {
+					outID, inID = inID, outID
+				}
+				tx.ID, leg.ID = outID, inID
+			}
+		}
+
+		updated, err := repo.UpdateTransaction(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to update transaction in repository: %w", err)
+		}
+
+		switch {
+		case leg != nil && oldLeg != nil:
+			leg, err = repo.UpdateTransaction(ctx, leg)
+			if err != nil {
+				return fmt.Errorf("failed to update transfer leg in repository: %w", err)
+			}
+		case leg != nil:
+			leg, err = repo.CreateTransaction(ctx, leg)
+			if err != nil {
+				return fmt.Errorf("failed to create transfer leg in repository: %w", err)
+			}
+		case oldLeg != nil:
+			deletedKeys, err = attachmentKeys(ctx, repo, oldLeg.ID)
+			if err != nil {
+				return err
+			}
+			if err := repo.DeleteTransaction(ctx, oldLeg.ID); err != nil {
+				return fmt.Errorf("failed to delete transfer leg in repository: %w", err)
+			}
+		}
+
+		changes := balanceChanges(oldTx, oldLeg).reverse().merge(balanceChanges(updated, leg))
+		if err := changes.apply(ctx, repo); err != nil {
+			return err
+		}
+
+		// the edited leg is returned
+		updatedTx = updated
+		if leg != nil && leg.ID == tid {
+			updatedTx = leg
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	cont.deleteBlobs(ctx, deletedKeys)
+
+	details := []wallet.Transaction{*updatedTx}
+	if err := cont.loadDetails(ctx, details); err != nil {
+		return nil, err
+	}
+
+	return details[0].ToProto(), nil
+}
+
+func (cont *walletControllerImpl) DeleteTransaction(
+	ctx context.Context,
+	userID string,
+	transactionID string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tid, err := uuid.Parse(transactionID)
+	if err != nil {
+		return fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	var deletedKeys []string
+	err = cont.repo.WithinTx(ctx, func(ctx context.Context, repo wallet.WalletRepository) error {
+		oldTx, err := repo.GetTransactionForUpdate(ctx, tid)
+		if err != nil {
+			return err
+		}
+		oldLeg, err := transferLegForUpdate(ctx, repo, oldTx)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := repo.LockAccounts(ctx, legAccountIDs(oldTx, oldLeg)...)
+		if err != nil {
+			return fmt.Errorf("failed to lock accounts: %w", err)
+		}
+		if err := checkOwnership(accounts, uid); err != nil {
+			return err
+		}
+
+		deletedIDs := []uuid.UUID{tid}
+		if oldLeg != nil {
+			deletedIDs = append(deletedIDs, oldLeg.ID)
+		}
+		deletedKeys, err = attachmentKeys(ctx, repo, deletedIDs...)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.DeleteTransaction(ctx, tid); err != nil {
+			return fmt.Errorf("failed to delete transaction in repository: %w", err)
+		}
+		if oldLeg != nil {
+			if err := repo.DeleteTransaction(ctx, oldLeg.ID); err != nil {
+				return fmt.Errorf("failed to delete transfer leg in repository: %w", err)
+			}
+		}
+
+		return balanceChanges(oldTx, oldLeg).reverse().apply(ctx, repo)
+	})
+	if err != nil {
+		return err
+	}
+
+	// the content goes only once the rows are gone for good
+	cont.deleteBlobs(ctx, deletedKeys)
+	return nil
+}
+
+// checkCategory makes sure an explicitly chosen category is visible to the
+// owner of the transaction.
+func (cont *walletControllerImpl) checkCategory(
+	ctx context.Context,
+	userID uuid.UUID,
+	tx *wallet.Transaction,
+) error {
+	if !tx.CategoryID.Valid {
+		return nil
+	}
+
+	if _, err := cont.categories.GetCategory(ctx, userID, tx.CategoryID.UUID); err != nil {
+		return fmt.Errorf("failed to check transaction category: %w", err)
+	}
+
+	return nil
+}
+
+func newTransaction(
+	accountID string,
+	toAccountID string,
+	txType common.TransactionType,
+	amount int64,
+	currency string,
+	categoryID string,
+	description string,
+	date time.Time,
+) (*wallet.Transaction, error) {
 	aid, err := uuid.Parse(accountID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid account ID: %w", err)
@@ -152,56 +696,85 @@ func (cont *walletControllerImpl) CreateTransaction(
 		CreatedAt: date,
 	}
 
-	if toAccountID != "" {
-		tx.ToAccountID = sql.NullString{String: toAccountID, Valid: true}
-	}
+	if tx.Type == "TRANSFER" {
+		if toAccountID == "" {
+			return nil, ErrTransferTargetRequired
+		}
 
-	if mcc != "" {
-		mccInt, err := strconv.Atoi(mcc)
-		if err == nil {
-			tx.MCC = sql.NullInt32{Int32: int32(mccInt), Valid: true}
+		toAid, err := uuid.Parse(toAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target account ID: %w", err)
+		}
+		if toAid == aid {
+			return nil, ErrTransferToSameAccount
 		}
+
+		tx.FromAccountID = uuid.NullUUID{UUID: aid, Valid: true}
+		tx.ToAccountID = uuid.NullUUID{UUID: toAid, Valid: true}
+	}
+
+	tx.CategoryID, tx.MCC, err = parseCategoryID(categoryID)
+	if err != nil {
+		return nil, err
 	}
 
 	if description != "" {
 		tx.Description = sql.NullString{String: description, Valid: true}
 	}
 
-	createdTx, err := cont.repo.CreateTransaction(ctx, tx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create transaction in repository: %w", err)
-	}
+	return tx, nil
+}
 
-	balanceChange := amount
+// newImportedTransaction builds the transaction of a row booked in the
+// account. A transfer into the account is booked as its incoming leg.
+func newImportedTransaction(
+	accountID string,
+	row ImportedTransaction,
+) (*wallet.Transaction, error) {
+	fromAccountID, toAccountID := accountID, row.ToAccountID
+	incoming := row.Type == common.TransactionType_TRANSACTION_TYPE_TRANSFER && row.FromAccountID != ""
+	if incoming {
+		fromAccountID, toAccountID = row.FromAccountID, accountID
+	}
 
-	switch txType {
-	case common.TransactionType_TRANSACTION_TYPE_EXPENSE:
-		balanceChange = -amount
-	case common.TransactionType_TRANSACTION_TYPE_TRANSFER:
-		balanceChange = -amount
+	tx, err := newTransaction(
+		fromAccountID,
+		toAccountID,
+		row.Type,
+		row.Amount,
+		row.Currency,
+		row.CategoryID,
+		row.Description,
+		row.Date,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if incoming {
+		tx.AccountID = tx.ToAccountID.UUID
+	}
 
-		if toAccountID != "" {
-			toAid, parseErr := uuid.Parse(toAccountID)
-			if parseErr == nil {
-				err = cont.repo.UpdateAccountBalance(ctx, toAid, amount)
+	return tx, nil
+}
 
-				if err != nil {
-					cont.logger.Error(
-						"failed to update account balance",
-						zap.Error(err),
-					)
-				}
-			}
-		}
+// transferLegForUpdate locks the other leg of a transfer. It returns nil for
+// other transactions and for transfers stored without their other leg.
+func transferLegForUpdate(
+	ctx context.Context,
+	repo wallet.WalletRepository,
+	tx *wallet.Transaction,
+) (*wallet.Transaction, error) {
+	if !tx.TransferID.Valid {
+		return nil, nil
 	}
 
-	err = cont.repo.UpdateAccountBalance(ctx, aid, balanceChange)
+	leg, err := repo.GetTransferLegForUpdate(ctx, tx)
+	if errors.Is(err, wallet.ErrTransactionNotFound) {
+		return nil, nil
+	}
 	if err != nil {
-		cont.logger.Error(
-			"failed to update account balance",
-			zap.Error(err),
-		)
+		return nil, err
 	}
 
-	return createdTx.ToProto(), nil
+	return leg, nil
 }
@@ -0,0 +1,183 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend-master/internal/data/repositories/wallet"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransferTargetRequired = errors.New("transfer requires a target account")
+	ErrTransferToSameAccount  = errors.New("transfer source and target accounts must differ")
+	ErrTransferAmountRequired = errors.New("transfer between accounts in different currencies requires the received amount")
+	ErrTransferCurrency       = errors.New("received amount must be in the currency of the target account")
+	ErrAccountNotOwned        = errors.New("account does not belong to user")
+	ErrAccountArchived        = errors.New("account is archived")
+)
+
+// balanceChangeSet holds the signed balance deltas a transaction applies to
+// the accounts it touches.
+type balanceChangeSet map[uuid.UUID]int64
+
+// balanceChanges computes the effect of transactions on the balances of their
+// accounts: income and the incoming leg of a transfer credit the account,
+// expenses and the outgoing leg of a transfer debit it. Nil transactions are
+// skipped.
+func balanceChanges(transactions ...*wallet.Transaction) balanceChangeSet {
+	changes := balanceChangeSet{}
+
+	for _, tx := range transactions {
+		if tx == nil {
+			continue
+		}
+
+		if tx.Type == "EXPENSE" || tx.IsOutgoingTransfer() {
+			changes[tx.AccountID] -= tx.Amount
+		} else {
+			changes[tx.AccountID] += tx.Amount
+		}
+	}
+
+	return changes
+}
+
+// counterAmount is what the other leg of a transfer moves in the currency of
+// its account. The zero value takes the transfer amount over, which needs
+// both accounts to share the currency.
+type counterAmount struct {
+	Amount   int64
+	Currency string
+}
+
+// otherAccountID returns the account of the other leg of a transfer.
+func otherAccountID(tx *wallet.Transaction) uuid.UUID {
+	if tx.IsOutgoingTransfer() {
+		return tx.ToAccountID.UUID
+	}
+	return tx.FromAccountID.UUID
+}
+
+// transferLeg builds the other leg of the transfer tx in otherAccount. Import
+// and schedule markers stay with tx.
+func transferLeg(
+	tx *wallet.Transaction,
+	counter counterAmount,
+	otherAccount wallet.Account,
+) (*wallet.Transaction, error) {
+	if counter.Currency != "" && counter.Currency != otherAccount.Currency {
+		return nil, fmt.Errorf(
+			"%w: got %s, want %s",
+			ErrTransferCurrency,
+			counter.Currency,
+			otherAccount.Currency,
+		)
+	}
+
+	amount := counter.Amount
+	if amount == 0 {
+		if tx.Currency != otherAccount.Currency {
+			return nil, ErrTransferAmountRequired
+		}
+		amount = tx.Amount
+	}
+
+	return &wallet.Transaction{
+		AccountID:     otherAccount.ID,
+		Type:          tx.Type,
+		Amount:        amount,
+		Currency:      otherAccount.Currency,
+		MCC:           tx.MCC,
+		CategoryID:    tx.CategoryID,
+		Description:   tx.Description,
+		CreatedAt:     tx.CreatedAt,
+		TransferID:    tx.TransferID,
+		FromAccountID: tx.FromAccountID,
+		ToAccountID:   tx.ToAccountID,
+	}, nil
+}
+
+// reverse returns the changes that undo c.
+func (c balanceChangeSet) reverse() balanceChangeSet {
+	reversed := make(balanceChangeSet, len(c))
+	for id, delta := range c {
+		reversed[id] = -delta
+	}
+	return reversed
+}
+
+// merge returns the combined effect of c followed by other.
+func (c balanceChangeSet) merge(other balanceChangeSet) balanceChangeSet {
+	merged := make(balanceChangeSet, len(c)+len(other))
+	for id, delta := range c {
+		merged[id] += delta
+	}
+	for id, delta := range other {
+		merged[id] += delta
+	}
+	return merged
+}
+
+// touchedAccountIDs returns the account of tx and, for a transfer, the
+// account of its other leg.
+func touchedAccountIDs(tx *wallet.Transaction) []uuid.UUID {
+	if tx.Type == "TRANSFER" {
+		return []uuid.UUID{tx.AccountID, otherAccountID(tx)}
+	}
+	return []uuid.UUID{tx.AccountID}
+}
+
+// legAccountIDs returns the accounts of the stored legs of a transaction,
+// oldLeg may be nil.
+func legAccountIDs(oldTx *wallet.Transaction, oldLeg *wallet.Transaction) []uuid.UUID {
+	if oldLeg == nil {
+		return []uuid.UUID{oldTx.AccountID}
+	}
+	return []uuid.UUID{oldTx.AccountID, oldLeg.AccountID}
+}
+
+func (c balanceChangeSet) apply(
+	ctx context.Context,
+	repo wallet.WalletRepository,
+) error {
+	for accountID, delta := range c {
+		if delta == 0 {
+			continue
+		}
+
+		if err := repo.UpdateAccountBalance(ctx, accountID, delta); err != nil {
+			return fmt.Errorf("failed to update account balance: %w", err)
+		}
+	}
+	return nil
+}
+
+func findAccount(accounts []wallet.Account, accountID uuid.UUID) wallet.Account {
+	for _, acc := range accounts {
+		if acc.ID == accountID {
+			return acc
+		}
+	}
+	return wallet.Account{}
+}
+
+func checkOwnership(accounts []wallet.Account, userID uuid.UUID) error {
+	for _, acc := range accounts {
+		if acc.UserID != userID {
+			return fmt.Errorf("account %s: %w", acc.ID.String(), ErrAccountNotOwned)
+		}
+	}
+	return nil
+}
+
+func checkNotArchived(accounts []wallet.Account) error {
+	for _, acc := range accounts {
+		if acc.ArchivedAt.Valid {
+			return fmt.Errorf("account %s: %w", acc.ID.String(), ErrAccountArchived)
+		}
+	}
+	return nil
+}
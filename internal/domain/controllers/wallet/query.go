@@ -0,0 +1,131 @@
+package wallet
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-master/internal/api-gen/proto/common"
+	pb "backend-master/internal/api-gen/proto/wallet"
+	"backend-master/internal/data/database"
+	"backend-master/internal/data/repositories/wallet"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+var (
+	ErrInvalidCategory = errors.New("category ID must be a category UUID or an MCC code")
+)
+
+// TransactionsQuery describes a page of GetUserTransactions. Zero values of the
+// optional fields disable the corresponding filter.
+type TransactionsQuery struct {
+	UserID      string
+	PageSize    int32
+	PageToken   string
+	StartDate   time.Time
+	EndDate     time.Time
+	AccountIDs  []string
+	Type        common.TransactionType
+	CategoryIDs []string // category IDs or MCC codes
+	Tags        []string // tag names
+	MinAmount   *int64
+	MaxAmount   *int64
+	Description string
+}
+
+type TransactionsPage struct {
+	Transactions  []*pb.Transaction
+	NextPageToken string
+}
+
+func (q *TransactionsQuery) toFilter() (*wallet.TransactionFilter, error) {
+	uid, err := uuid.Parse(q.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	filter := &wallet.TransactionFilter{
+		UserID:      uid,
+		StartDate:   q.StartDate,
+		EndDate:     q.EndDate,
+		MinAmount:   q.MinAmount,
+		MaxAmount:   q.MaxAmount,
+		Description: q.Description,
+		Limit:       int(q.PageSize),
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultPageSize
+	case filter.Limit > maxPageSize:
+		filter.Limit = maxPageSize
+	}
+
+	if q.Type != common.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+		filter.Type = wallet.TransactionPbTypeToDbType(q.Type)
+	}
+
+	for _, accountID := range q.AccountIDs {
+		aid, err := uuid.Parse(accountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account ID %q: %w", accountID, err)
+		}
+		filter.AccountIDs = append(filter.AccountIDs, aid)
+	}
+
+	for _, categoryID := range q.CategoryIDs {
+		cid, mcc, err := parseCategoryID(categoryID)
+		if err != nil {
+			return nil, err
+		}
+		if cid.Valid {
+			filter.CategoryIDs = append(filter.CategoryIDs, cid.UUID)
+		}
+		if mcc.Valid {
+			filter.MCCs = append(filter.MCCs, mcc.Int32)
+		}
+	}
+
+	for _, tag := range q.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			filter.Tags = append(filter.Tags, tag)
+		}
+	}
+
+	if q.PageToken != "" {
+		cursor, err := database.DecodeCursor(q.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = cursor
+	}
+
+	return filter, nil
+}
+
+// parseCategoryID accepts either a category UUID or a numeric MCC code, so
+// clients that still send MCCs keep working.
+func parseCategoryID(categoryID string) (uuid.NullUUID, sql.NullInt32, error) {
+	if categoryID == "" {
+		return uuid.NullUUID{}, sql.NullInt32{}, nil
+	}
+
+	if mcc, err := strconv.ParseInt(categoryID, 10, 32); err == nil {
+		return uuid.NullUUID{}, sql.NullInt32{Int32: int32(mcc), Valid: true}, nil
+	}
+
+	if cid, err := uuid.Parse(categoryID); err == nil {
+		return uuid.NullUUID{UUID: cid, Valid: true}, sql.NullInt32{}, nil
+	}
+
+	return uuid.NullUUID{}, sql.NullInt32{}, fmt.Errorf("%w: %q", ErrInvalidCategory, categoryID)
+}
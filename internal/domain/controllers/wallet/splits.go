@@ -0,0 +1,213 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	pb "backend-master/internal/api-gen/proto/wallet"
+	"backend-master/internal/data/repositories/wallet"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSplitTransfer    = errors.New("transfers cannot be split")
+	ErrSplitAmount      = errors.New("split amount must be positive")
+	ErrSplitSumMismatch = errors.New("split amounts must add up to the transaction amount")
+)
+
+func (cont *walletControllerImpl) SetTransactionSplits(
+	ctx context.Context,
+	userID string,
+	transactionID string,
+	pbSplits []*pb.TransactionSplit,
+) (*pb.Transaction, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tid, err := uuid.Parse(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	splits := make([]wallet.Split, 0, len(pbSplits))
+	for _, pbSplit := range pbSplits {
+		if pbSplit.Amount <= 0 {
+			return nil, ErrSplitAmount
+		}
+
+		split := wallet.Split{Amount: pbSplit.Amount}
+
+		split.CategoryID, split.MCC, err = parseCategoryID(pbSplit.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+
+		if note := strings.TrimSpace(pbSplit.Note); note != "" {
+			split.Note = sql.NullString{String: note, Valid: true}
+		}
+
+		splits = append(splits, split)
+	}
+
+	var splitTx *wallet.Transaction
+	err = cont.repo.WithinTx(ctx, func(ctx context.Context, repo wallet.WalletRepository) error {
+		tx, err := repo.GetTransactionForUpdate(ctx, tid)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := repo.LockAccounts(ctx, tx.AccountID)
+		if err != nil {
+			return fmt.Errorf("failed to lock account: %w", err)
+		}
+		if err := checkOwnership(accounts, uid); err != nil {
+			return err
+		}
+		if err := checkNotArchived(accounts); err != nil {
+			return err
+		}
+
+		if err := checkSplits(tx, splits); err != nil {
+			return err
+		}
+		for _, split := range splits {
+			if !split.CategoryID.Valid {
+				continue
+			}
+			if _, err := cont.categories.GetCategory(ctx, uid, split.CategoryID.UUID); err != nil {
+				return fmt.Errorf("failed to check split category: %w", err)
+			}
+		}
+
+		tx.Splits, err = repo.ReplaceSplits(ctx, tid, splits)
+		if err != nil {
+			return fmt.Errorf("failed to replace splits in repository: %w", err)
+		}
+
+		if tx.Type == "EXPENSE" {
+			// every category of the split lines is checked once, or the
+			// one of the whole transaction when it is no longer split
+			type splitCategory struct {
+				mcc        sql.NullInt32
+				categoryID uuid.NullUUID
+			}
+			categories := []splitCategory{{mcc: tx.MCC, categoryID: tx.CategoryID}}
+			if len(tx.Splits) > 0 {
+				categories = categories[:0]
+				for _, split := range tx.Splits {
+					categories = append(categories, splitCategory{mcc: split.MCC, categoryID: split.CategoryID})
+				}
+			}
+
+			checked := make(map[splitCategory]bool, len(categories))
+			for _, c := range categories {
+				if checked[c] {
+					continue
+				}
+				checked[c] = true
+
+				err := cont.budgets.CheckThresholds(
+					ctx,
+					uid,
+					c.mcc,
+					c.categoryID,
+					tx.Currency,
+					tx.CreatedAt,
+				)
+				if err != nil {
+					return fmt.Errorf("failed to check budgets: %w", err)
+				}
+			}
+		}
+
+		splitTx = tx
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	details := []wallet.Transaction{*splitTx}
+	if err := cont.loadDetails(ctx, details); err != nil {
+		return nil, err
+	}
+
+	return details[0].ToProto(), nil
+}
+
+// checkSplits makes sure the split lines, if any, fit the transaction.
+func checkSplits(tx *wallet.Transaction, splits []wallet.Split) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	if tx.Type == "TRANSFER" {
+		return ErrSplitTransfer
+	}
+
+	var sum int64
+	for _, split := range splits {
+		sum += split.Amount
+	}
+	if sum != tx.Amount {
+		return ErrSplitSumMismatch
+	}
+
+	return nil
+}
+
+// loadDetails attaches their split lines, tags, attachments and the other
+// legs of transfers to the transactions.
+func (cont *walletControllerImpl) loadDetails(
+	ctx context.Context,
+	transactions []wallet.Transaction,
+) error {
+	ids := make([]uuid.UUID, 0, len(transactions))
+	var transferIDs []uuid.UUID
+	for _, tx := range transactions {
+		ids = append(ids, tx.ID)
+		if tx.TransferID.Valid {
+			transferIDs = append(transferIDs, tx.TransferID.UUID)
+		}
+	}
+
+	splits, err := cont.repo.GetSplits(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get splits from repository: %w", err)
+	}
+
+	tags, err := cont.repo.GetTransactionTags(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get tags from repository: %w", err)
+	}
+
+	attachments, err := cont.repo.GetAttachments(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get attachments from repository: %w", err)
+	}
+
+	legs, err := cont.repo.GetTransferLegs(ctx, transferIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get transfer legs from repository: %w", err)
+	}
+
+	for i := range transactions {
+		transactions[i].Splits = splits[transactions[i].ID]
+		transactions[i].Tags = tags[transactions[i].ID]
+		transactions[i].Attachments = attachments[transactions[i].ID]
+
+		for _, leg := range legs[transactions[i].TransferID.UUID] {
+			if transactions[i].TransferID.Valid && leg.ID != transactions[i].ID {
+				transactions[i].Counterpart = &leg
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"backend-master/internal/data/repositories/attachment"
+	"backend-master/internal/data/storage"
+	attachmentController "backend-master/internal/domain/controllers/attachment"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// multipartOverhead is allowed on top of the attachment size limit for the
+// multipart boundaries and headers of the upload request.
+const multipartOverhead = 64 << 10
+
+// NewUploadAttachmentHandler attaches a file to a transaction. It takes a
+// multipart form with the file in the "file" field; user_id is read from the
+// query or the form.
+func NewUploadAttachmentHandler(
+	attachmentCtrl attachmentController.AttachmentController,
+	logger *zap.Logger,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		transactionID := ctx.Param("transaction_id")
+		logger.Info(
+			"UploadAttachment",
+			zap.String("transaction_id", transactionID),
+			zap.String("query", ctx.Request.URL.RawQuery),
+		)
+
+		ctx.Request.Body = http.MaxBytesReader(
+			ctx.Writer,
+			ctx.Request.Body,
+			attachmentCtrl.MaxSize()+multipartOverhead,
+		)
+
+		file, header, err := ctx.Request.FormFile("file")
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"message": attachmentController.ErrAttachmentTooLarge.Error()})
+				return
+			}
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("invalid upload: %v", err)})
+			return
+		}
+		defer file.Close()
+
+		userID := ctx.Query("user_id")
+		if userID == "" {
+			userID = ctx.Request.FormValue("user_id")
+		}
+
+		created, err := attachmentCtrl.Upload(
+			ctx.Request.Context(),
+			userID,
+			transactionID,
+			header.Filename,
+			file,
+		)
+		if err != nil {
+			if errors.Is(err, attachmentController.ErrAttachmentTooLarge) {
+				ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"message": err.Error()})
+				return
+			}
+			writeAttachmentError(ctx, logger, "failed to upload attachment", err)
+			return
+		}
+
+		// same encoding as the attachments of transactions served by the
+		// gateway
+		body, err := protojson.Marshal(created.ToProto())
+		if err != nil {
+			writeAttachmentError(ctx, logger, "failed to upload attachment", err)
+			return
+		}
+		ctx.Data(http.StatusCreated, "application/json", body)
+	}
+}
+
+// NewDownloadAttachmentHandler sends the content of an attachment. Query
+// parameters: user_id.
+func NewDownloadAttachmentHandler(
+	attachmentCtrl attachmentController.AttachmentController,
+	logger *zap.Logger,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		attachmentID := ctx.Param("attachment_id")
+		logger.Info(
+			"DownloadAttachment",
+			zap.String("attachment_id", attachmentID),
+			zap.String("query", ctx.Request.URL.RawQuery),
+		)
+
+		meta, content, err := attachmentCtrl.Download(
+			ctx.Request.Context(),
+			ctx.Query("user_id"),
+			attachmentID,
+		)
+		if err != nil {
+			writeAttachmentError(ctx, logger, "failed to download attachment", err)
+			return
+		}
+		defer content.Close()
+
+		ctx.Header("Content-Type", meta.ContentType)
+		ctx.Header("Content-Length", strconv.FormatInt(meta.Size, 10))
+		ctx.Header(
+			"Content-Disposition",
+			mime.FormatMediaType("attachment", map[string]string{"filename": meta.FileName}),
+		)
+		ctx.Status(http.StatusOK)
+		if _, err := io.Copy(ctx.Writer, content); err != nil {
+			// the status is already sent, the client sees a cut off file
+			logger.Error("attachment download interrupted", zap.Error(err))
+		}
+	}
+}
+
+// NewDeleteAttachmentHandler removes an attachment. Query parameters:
+// user_id.
+func NewDeleteAttachmentHandler(
+	attachmentCtrl attachmentController.AttachmentController,
+	logger *zap.Logger,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		attachmentID := ctx.Param("attachment_id")
+		logger.Info(
+			"DeleteAttachment",
+			zap.String("attachment_id", attachmentID),
+			zap.String("query", ctx.Request.URL.RawQuery),
+		)
+
+		err := attachmentCtrl.Delete(ctx.Request.Context(), ctx.Query("user_id"), attachmentID)
+		if err != nil {
+			writeAttachmentError(ctx, logger, "failed to delete attachment", err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+func writeAttachmentError(ctx *gin.Context, logger *zap.Logger, message string, err error) {
+	switch {
+	case errors.Is(err, attachmentController.ErrInvalidAttachment):
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+
+	case errors.Is(err, attachment.ErrAttachmentNotFound),
+		errors.Is(err, attachment.ErrTransactionNotFound),
+		errors.Is(err, storage.ErrBlobNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": err.Error()})
+
+	default:
+		logger.Error(message, zap.Error(err))
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": message})
+	}
+}
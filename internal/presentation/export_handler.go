@@ -0,0 +1,96 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"backend-master/internal/domain/controllers/export"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// NewExportHandler streams a user's transactions as a file. Query parameters:
+// user_id, format (csv, jsonl or ofx, csv by default), start_date and
+// end_date (RFC 3339 or YYYY-MM-DD, the end is exclusive) and account_id,
+// which may be repeated.
+func NewExportHandler(exportCtrl export.ExportController, logger *zap.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		logger.Info("ExportTransactions", zap.String("query", ctx.Request.URL.RawQuery))
+
+		query := export.Query{
+			UserID:     ctx.Query("user_id"),
+			AccountIDs: ctx.QueryArray("account_id"),
+			Format:     export.Format(ctx.DefaultQuery("format", string(export.FormatCSV))),
+		}
+
+		var err error
+		if query.StartDate, err = parseExportDate(ctx.Query("start_date")); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("invalid start_date: %v", err)})
+			return
+		}
+		if query.EndDate, err = parseExportDate(ctx.Query("end_date")); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("invalid end_date: %v", err)})
+			return
+		}
+
+		w := &exportWriter{ctx: ctx, format: query.Format}
+		err = exportCtrl.ExportTransactions(ctx.Request.Context(), query, w)
+		switch {
+		case err == nil:
+			w.start()
+
+		case w.started:
+			// the status is already sent, the client sees a cut off file
+			logger.Error("transaction export interrupted", zap.Error(err))
+
+		case errors.Is(err, export.ErrInvalidQuery):
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+
+		default:
+			logger.Error("failed to export transactions", zap.Error(err))
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "failed to export transactions"})
+		}
+	}
+}
+
+// exportWriter sends the response headers with the first bytes of the
+// export, so a query rejected before anything is written still gets an
+// error response.
+type exportWriter struct {
+	ctx     *gin.Context
+	format  export.Format
+	started bool
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	w.start()
+	return w.ctx.Writer.Write(p)
+}
+
+func (w *exportWriter) start() {
+	if w.started {
+		return
+	}
+	w.started = true
+
+	w.ctx.Header("Content-Type", w.format.ContentType())
+	w.ctx.Header(
+		"Content-Disposition",
+		fmt.Sprintf(`attachment; filename="transactions.%s"`, w.format),
+	)
+	w.ctx.Status(http.StatusOK)
+	w.ctx.Writer.WriteHeaderNow()
+}
+
+func parseExportDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if date, err := time.Parse(time.DateOnly, s); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
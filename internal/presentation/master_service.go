@@ -2,15 +2,34 @@ package presentation
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"backend-master/internal/api-gen/proto/common"
 	pb "backend-master/internal/api-gen/proto/master"
+	currencyRepo "backend-master/internal/data/repositories/currency"
+	"backend-master/internal/data/secrets"
 	anal "backend-master/internal/domain/controllers/analyzer"
+	"backend-master/internal/domain/controllers/balance"
+	"backend-master/internal/domain/controllers/budget"
+	"backend-master/internal/domain/controllers/category"
+	"backend-master/internal/domain/controllers/currency"
+	"backend-master/internal/domain/controllers/goal"
+	"backend-master/internal/domain/controllers/imports"
 	"backend-master/internal/domain/controllers/market"
+	"backend-master/internal/domain/controllers/networth"
+	"backend-master/internal/domain/controllers/notification"
+	"backend-master/internal/domain/controllers/recurring"
+	"backend-master/internal/domain/controllers/rule"
+	"backend-master/internal/domain/controllers/tag"
 	"backend-master/internal/domain/controllers/wallet"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type masterServiceImpl struct {
@@ -19,6 +38,17 @@ type masterServiceImpl struct {
 	walletCtrl   wallet.WalletController
 	marketCtrl   market.MarketController
 	analyzerCtrl anal.AnalyzerController
+	currencyCtrl currency.CurrencyController
+	netWorthCtrl networth.NetWorthController
+	notifyCtrl   notification.NotificationController
+	budgetCtrl   budget.BudgetController
+	goalCtrl     goal.GoalController
+	categoryCtrl category.CategoryController
+	ruleCtrl     rule.RuleController
+	importCtrl   imports.ImportController
+	recurCtrl    recurring.RecurringController
+	tagCtrl      tag.TagController
+	balanceCtrl  balance.BalanceController
 }
 
 func NewMasterService(
@@ -26,26 +56,60 @@ func NewMasterService(
 	walletCtrl wallet.WalletController,
 	marketCtrl market.MarketController,
 	analyzerCtrl anal.AnalyzerController,
+	currencyCtrl currency.CurrencyController,
+	netWorthCtrl networth.NetWorthController,
+	notifyCtrl notification.NotificationController,
+	budgetCtrl budget.BudgetController,
+	goalCtrl goal.GoalController,
+	categoryCtrl category.CategoryController,
+	ruleCtrl rule.RuleController,
+	importCtrl imports.ImportController,
+	recurCtrl recurring.RecurringController,
+	tagCtrl tag.TagController,
+	balanceCtrl balance.BalanceController,
 ) pb.MasterServiceServer {
 	return &masterServiceImpl{
 		logger:       logger,
 		walletCtrl:   walletCtrl,
 		marketCtrl:   marketCtrl,
 		analyzerCtrl: analyzerCtrl,
+		currencyCtrl: currencyCtrl,
+		netWorthCtrl: netWorthCtrl,
+		notifyCtrl:   notifyCtrl,
+		budgetCtrl:   budgetCtrl,
+		goalCtrl:     goalCtrl,
+		categoryCtrl: categoryCtrl,
+		ruleCtrl:     ruleCtrl,
+		importCtrl:   importCtrl,
+		recurCtrl:    recurCtrl,
+		tagCtrl:      tagCtrl,
+		balanceCtrl:  balanceCtrl,
 	}
 }
 
 func (s *masterServiceImpl) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.CreateTransactionResponse, error) {
 	s.logger.Info("CreateTransaction", zap.String("body", fmt.Sprintf("%v", req)))
 
+	switch {
+	case req.Amount == nil:
+		return nil, status.Error(codes.InvalidArgument, "amount is required")
+	case req.Amount.Amount <= 0:
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	case req.Date == nil:
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
 	tx, err := s.walletCtrl.CreateTransaction(
 		ctx,
+		req.UserId,
 		req.FromAccountId,
 		req.ToAccountId,
 		req.Type,
 		req.Amount.Amount,
 		req.Amount.Currency,
-		req.CategoryId, // mcc
+		req.GetToAmount().GetAmount(),
+		req.GetToAmount().GetCurrency(),
+		req.CategoryId,
 		req.Description,
 		req.Date.AsTime(),
 	)
@@ -58,16 +122,94 @@ func (s *masterServiceImpl) CreateTransaction(ctx context.Context, req *pb.Creat
 	}, nil
 }
 
+func (s *masterServiceImpl) UpdateTransaction(ctx context.Context, req *pb.UpdateTransactionRequest) (*pb.UpdateTransactionResponse, error) {
+	s.logger.Info("UpdateTransaction", zap.String("body", fmt.Sprintf("%v", req)))
+
+	// the whole transaction is replaced, so unset fields would zero it out
+	switch {
+	case req.Amount == nil:
+		return nil, status.Error(codes.InvalidArgument, "amount is required")
+	case req.Amount.Amount <= 0:
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	case req.Date == nil:
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	tx, err := s.walletCtrl.UpdateTransaction(
+		ctx,
+		req.UserId,
+		req.TransactionId,
+		req.FromAccountId,
+		req.ToAccountId,
+		req.Type,
+		req.Amount.Amount,
+		req.Amount.Currency,
+		req.GetToAmount().GetAmount(),
+		req.GetToAmount().GetCurrency(),
+		req.CategoryId,
+		req.Description,
+		req.Date.AsTime(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	return &pb.UpdateTransactionResponse{
+		Transaction: tx,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionRequest) (*pb.DeleteTransactionResponse, error) {
+	s.logger.Info("DeleteTransaction", zap.String("body", fmt.Sprintf("%v", req)))
+
+	err := s.walletCtrl.DeleteTransaction(ctx, req.UserId, req.TransactionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete transaction: %w", err)
+	}
+
+	return &pb.DeleteTransactionResponse{}, nil
+}
+
+func (s *masterServiceImpl) SetTransactionSplits(ctx context.Context, req *pb.SetTransactionSplitsRequest) (*pb.SetTransactionSplitsResponse, error) {
+	s.logger.Info("SetTransactionSplits", zap.String("body", fmt.Sprintf("%v", req)))
+
+	tx, err := s.walletCtrl.SetTransactionSplits(ctx, req.UserId, req.TransactionId, req.Splits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set transaction splits: %w", err)
+	}
+
+	return &pb.SetTransactionSplitsResponse{
+		Transaction: tx,
+	}, nil
+}
+
 func (s *masterServiceImpl) GetTransactions(ctx context.Context, req *pb.GetTransactionsRequest) (*pb.GetTransactionsResponse, error) {
-	s.logger.Info("GetBalance", zap.String("body", fmt.Sprintf("%v", req)))
+	s.logger.Info("GetTransactions", zap.String("body", fmt.Sprintf("%v", req)))
 
-	resp, err := s.walletCtrl.GetUserTransactions(ctx, req.UserId)
+	page, err := s.walletCtrl.GetUserTransactions(
+		ctx,
+		wallet.TransactionsQuery{
+			UserID:      req.UserId,
+			PageSize:    req.PageSize,
+			PageToken:   req.PageToken,
+			StartDate:   optionalTime(req.StartDate),
+			EndDate:     optionalTime(req.EndDate),
+			AccountIDs:  req.AccountIds,
+			Type:        req.Type,
+			CategoryIDs: req.CategoryIds,
+			Tags:        req.Tags,
+			MinAmount:   req.MinAmount,
+			MaxAmount:   req.MaxAmount,
+			Description: req.Description,
+		},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user transactions: %w", err)
 	}
 
 	return &pb.GetTransactionsResponse{
-		Transactions: resp.Transactions,
+		Transactions:  page.Transactions,
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
 
@@ -80,35 +222,143 @@ func (s *masterServiceImpl) GetBalance(ctx context.Context, req *pb.GetBalanceRe
 		return nil, err
 	}
 
+	targetCurrency := strings.ToUpper(req.Currency)
+	if targetCurrency == "" {
+		targetCurrency = currency.PivotCurrency
+	}
+
+	now := time.Now()
+
 	var totalBalance int64
+	accountBalances := make([]*pb.AccountBalance, 0, len(accountsResp.Accounts))
 	for _, account := range accountsResp.Accounts {
-		if account.Balance != nil {
-			totalBalance += account.Balance.Amount
+		if account.Balance == nil {
+			continue
+		}
+
+		conversion, err := s.currencyCtrl.Convert(
+			ctx,
+			account.Balance.Amount,
+			account.Balance.Currency,
+			targetCurrency,
+			now,
+		)
+		if errors.Is(err, currencyRepo.ErrRateNotFound) {
+			// the balance is still reported, but left out of the total
+			s.logger.Warn(
+				"no exchange rate for account balance",
+				zap.String("account_id", account.AccountId),
+				zap.Error(err),
+			)
+			accountBalances = append(accountBalances, &pb.AccountBalance{
+				AccountId:   account.AccountId,
+				Balance:     account.Balance,
+				RateMissing: true,
+			})
+			continue
+		}
+		if err != nil {
+			s.logger.Error(
+				"failed to convert account balance",
+				zap.String("account_id", account.AccountId),
+				zap.Error(err),
+			)
+			return nil, err
 		}
+
+		totalBalance += conversion.Amount
+		accountBalances = append(accountBalances, &pb.AccountBalance{
+			AccountId: account.AccountId,
+			Balance:   account.Balance,
+			ConvertedBalance: &common.Money{
+				Amount:   conversion.Amount,
+				Currency: conversion.Currency,
+			},
+			Rate:     conversion.Rate.FloatString(6),
+			RateDate: timestamppb.New(conversion.RateDate),
+		})
 	}
 
 	return &pb.GetBalanceResponse{
 		TotalBalance: &common.Money{
 			Amount:   totalBalance,
-			Currency: "RUB",
+			Currency: targetCurrency,
 		},
-		Accounts: accountsResp.Accounts,
+		Accounts:        accountsResp.Accounts,
+		AccountBalances: accountBalances,
 	}, nil
 }
 
+func (s *masterServiceImpl) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	s.logger.Info("CreateAccount", zap.String("body", fmt.Sprintf("%v", req)))
+
+	account, err := s.walletCtrl.CreateAccount(
+		ctx,
+		req.UserId,
+		req.Name,
+		req.Type,
+		req.GetInitialBalance().GetAmount(),
+		req.GetInitialBalance().GetCurrency(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account: %w", err)
+	}
+
+	return &pb.CreateAccountResponse{
+		Account: account,
+	}, nil
+}
+
+func (s *masterServiceImpl) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.UpdateAccountResponse, error) {
+	s.logger.Info("UpdateAccount", zap.String("body", fmt.Sprintf("%v", req)))
+
+	account, err := s.walletCtrl.UpdateAccount(ctx, req.UserId, req.AccountId, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return &pb.UpdateAccountResponse{
+		Account: account,
+	}, nil
+}
+
+func (s *masterServiceImpl) ArchiveAccount(ctx context.Context, req *pb.ArchiveAccountRequest) (*pb.ArchiveAccountResponse, error) {
+	s.logger.Info("ArchiveAccount", zap.String("body", fmt.Sprintf("%v", req)))
+
+	account, err := s.walletCtrl.ArchiveAccount(ctx, req.UserId, req.AccountId, req.Archived)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive account: %w", err)
+	}
+
+	return &pb.ArchiveAccountResponse{
+		Account: account,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	s.logger.Info("DeleteAccount", zap.String("body", fmt.Sprintf("%v", req)))
+
+	err := s.walletCtrl.DeleteAccount(ctx, req.UserId, req.AccountId, req.ReassignToAccountId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	return &pb.DeleteAccountResponse{}, nil
+}
+
 func (s *masterServiceImpl) GetAnalytics(ctx context.Context, req *pb.GetAnalyticsRequest) (*pb.GetAnalyticsResponse, error) {
 	s.logger.Info("GetAnalytics", zap.String("body", fmt.Sprintf("%v", req)))
 
 	stats, err := s.analyzerCtrl.GetStatistics(
 		ctx,
 		req.UserId,
-		req.StartDate.AsTime(),
-		req.EndDate.AsTime(),
-		common.TimePeriod_TIME_PERIOD_MONTH,
+		optionalTime(req.StartDate),
+		optionalTime(req.EndDate),
+		req.GroupBy,
 	)
 	if err != nil {
 		s.logger.Error("failed to get statistics", zap.Error(err))
-		return nil, err
+		return nil, analyzerError(err)
 	}
 
 	return &pb.GetAnalyticsResponse{
@@ -127,10 +377,788 @@ func (s *masterServiceImpl) GetForecast(ctx context.Context, req *pb.GetForecast
 	)
 	if err != nil {
 		s.logger.Error("failed to get forecast", zap.Error(err))
-		return nil, err
+		return nil, analyzerError(err)
 	}
 
 	return &pb.GetForecastResponse{
 		Forecasts: forecast.Forecasts,
 	}, nil
 }
+
+func (s *masterServiceImpl) GetAnomalies(ctx context.Context, req *pb.GetAnomaliesRequest) (*pb.GetAnomaliesResponse, error) {
+	s.logger.Info("GetAnomalies", zap.String("body", fmt.Sprintf("%v", req)))
+
+	anomalies, err := s.analyzerCtrl.GetAnomalies(ctx, req.UserId, req.Period)
+	if err != nil {
+		s.logger.Error("failed to get anomalies", zap.Error(err))
+		return nil, analyzerError(err)
+	}
+
+	return &pb.GetAnomaliesResponse{
+		Anomalies: anomalies.Anomalies,
+	}, nil
+}
+
+func (s *masterServiceImpl) GetUpcomingRecurring(ctx context.Context, req *pb.GetUpcomingRecurringRequest) (*pb.GetUpcomingRecurringResponse, error) {
+	s.logger.Info("GetUpcomingRecurring", zap.String("body", fmt.Sprintf("%v", req)))
+
+	recurring, err := s.analyzerCtrl.GetUpcomingRecurring(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("failed to get upcoming recurring payments", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.GetUpcomingRecurringResponse{
+		Payments: recurring.Payments,
+	}, nil
+}
+
+func (s *masterServiceImpl) GetInvestmentPositions(ctx context.Context, req *pb.GetInvestmentPositionsRequest) (*pb.GetInvestmentPositionsResponse, error) {
+	s.logger.Info("GetInvestmentPositions", zap.String("body", fmt.Sprintf("%v", req)))
+
+	positions, err := s.marketCtrl.GetInvestmentPositions(ctx, req.UserId, req.AccountId)
+	if err != nil {
+		s.logger.Error("failed to get investment positions", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.GetInvestmentPositionsResponse{
+		Positions: positions.Positions,
+	}, nil
+}
+
+func (s *masterServiceImpl) GetSecurity(ctx context.Context, req *pb.GetSecurityRequest) (*pb.GetSecurityResponse, error) {
+	s.logger.Info("GetSecurity", zap.String("body", fmt.Sprintf("%v", req)))
+
+	security, err := s.marketCtrl.GetSecurity(ctx, req.Figi)
+	if err != nil {
+		s.logger.Error("failed to get security", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.GetSecurityResponse{
+		Security: security.Security,
+	}, nil
+}
+
+func (s *masterServiceImpl) GetSecuritiesPrices(ctx context.Context, req *pb.GetSecuritiesPricesRequest) (*pb.GetSecuritiesPricesResponse, error) {
+	s.logger.Info("GetSecuritiesPrices", zap.String("body", fmt.Sprintf("%v", req)))
+
+	securities, err := s.marketCtrl.GetSecuritiesPrices(ctx, req.Figis)
+	if err != nil {
+		s.logger.Error("failed to get securities prices", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.GetSecuritiesPricesResponse{
+		Securities: securities.Securities,
+	}, nil
+}
+
+func (s *masterServiceImpl) GetSecurityPayments(ctx context.Context, req *pb.GetSecurityPaymentsRequest) (*pb.GetSecurityPaymentsResponse, error) {
+	s.logger.Info("GetSecurityPayments", zap.String("body", fmt.Sprintf("%v", req)))
+
+	payments, err := s.marketCtrl.GetSecurityPayments(
+		ctx,
+		req.Figis,
+		optionalTime(req.StartDate),
+		optionalTime(req.EndDate),
+	)
+	if err != nil {
+		s.logger.Error("failed to get security payments", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.GetSecurityPaymentsResponse{
+		Payments: payments.Payments,
+	}, nil
+}
+
+func (s *masterServiceImpl) LinkBroker(ctx context.Context, req *pb.LinkBrokerRequest) (*pb.LinkBrokerResponse, error) {
+	s.logger.Info("LinkBroker", zap.String("account_id", req.AccountId), zap.String("backend_type", req.BackendType))
+
+	link, err := s.marketCtrl.LinkBroker(
+		ctx,
+		req.UserId,
+		req.AccountId,
+		req.BackendType,
+		req.ExternalAccountId,
+		req.Token,
+	)
+	if err != nil {
+		s.logger.Error("failed to link broker", zap.Error(err))
+		if errors.Is(err, secrets.ErrNoKey) {
+			return nil, status.Error(codes.FailedPrecondition, "broker linking is not configured")
+		}
+		return nil, err
+	}
+
+	return &pb.LinkBrokerResponse{
+		Link: link,
+	}, nil
+}
+
+func (s *masterServiceImpl) UnlinkBroker(ctx context.Context, req *pb.UnlinkBrokerRequest) (*pb.UnlinkBrokerResponse, error) {
+	s.logger.Info("UnlinkBroker", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.marketCtrl.UnlinkBroker(ctx, req.UserId, req.AccountId); err != nil {
+		s.logger.Error("failed to unlink broker", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.UnlinkBrokerResponse{}, nil
+}
+
+func (s *masterServiceImpl) GetNetWorth(ctx context.Context, req *pb.GetNetWorthRequest) (*pb.GetNetWorthResponse, error) {
+	s.logger.Info("GetNetWorth", zap.String("body", fmt.Sprintf("%v", req)))
+
+	netWorth, err := s.netWorthCtrl.GetNetWorth(ctx, req.UserId, req.Currency)
+	if err != nil {
+		s.logger.Error("failed to get net worth", zap.Error(err))
+		return nil, err
+	}
+
+	return netWorth, nil
+}
+
+func (s *masterServiceImpl) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	s.logger.Info("ListNotifications", zap.String("body", fmt.Sprintf("%v", req)))
+
+	page, err := s.notifyCtrl.ListNotifications(
+		ctx,
+		req.UserId,
+		req.PageSize,
+		req.PageToken,
+		req.UnreadOnly,
+	)
+	if err != nil {
+		s.logger.Error("failed to list notifications", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.ListNotificationsResponse{
+		Notifications: page.Notifications,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (s *masterServiceImpl) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.MarkNotificationsReadResponse, error) {
+	s.logger.Info("MarkNotificationsRead", zap.String("body", fmt.Sprintf("%v", req)))
+
+	updated, err := s.notifyCtrl.MarkRead(ctx, req.UserId, req.NotificationIds, req.Read)
+	if err != nil {
+		s.logger.Error("failed to mark notifications", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.MarkNotificationsReadResponse{
+		UpdatedCount: updated,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteNotification(ctx context.Context, req *pb.DeleteNotificationRequest) (*pb.DeleteNotificationResponse, error) {
+	s.logger.Info("DeleteNotification", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.notifyCtrl.DeleteNotification(ctx, req.UserId, req.NotificationId); err != nil {
+		s.logger.Error("failed to delete notification", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.DeleteNotificationResponse{}, nil
+}
+
+func (s *masterServiceImpl) GetUnreadNotificationsCount(ctx context.Context, req *pb.GetUnreadNotificationsCountRequest) (*pb.GetUnreadNotificationsCountResponse, error) {
+	s.logger.Info("GetUnreadNotificationsCount", zap.String("body", fmt.Sprintf("%v", req)))
+
+	count, err := s.notifyCtrl.GetUnreadCount(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("failed to count unread notifications", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.GetUnreadNotificationsCountResponse{
+		Count: count,
+	}, nil
+}
+
+func (s *masterServiceImpl) CreateBudget(ctx context.Context, req *pb.CreateBudgetRequest) (*pb.CreateBudgetResponse, error) {
+	s.logger.Info("CreateBudget", zap.String("body", fmt.Sprintf("%v", req)))
+
+	created, err := s.budgetCtrl.CreateBudget(
+		ctx,
+		req.UserId,
+		req.CategoryId,
+		req.Period,
+		req.GetLimit().GetAmount(),
+		req.GetLimit().GetCurrency(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create budget: %w", err)
+	}
+
+	return &pb.CreateBudgetResponse{
+		Budget: created,
+	}, nil
+}
+
+func (s *masterServiceImpl) UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRequest) (*pb.UpdateBudgetResponse, error) {
+	s.logger.Info("UpdateBudget", zap.String("body", fmt.Sprintf("%v", req)))
+
+	updated, err := s.budgetCtrl.UpdateBudget(
+		ctx,
+		req.UserId,
+		req.BudgetId,
+		req.GetLimit().GetAmount(),
+		req.GetLimit().GetCurrency(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	return &pb.UpdateBudgetResponse{
+		Budget: updated,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.DeleteBudgetResponse, error) {
+	s.logger.Info("DeleteBudget", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.budgetCtrl.DeleteBudget(ctx, req.UserId, req.BudgetId); err != nil {
+		return nil, fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	return &pb.DeleteBudgetResponse{}, nil
+}
+
+func (s *masterServiceImpl) ListBudgets(ctx context.Context, req *pb.ListBudgetsRequest) (*pb.ListBudgetsResponse, error) {
+	s.logger.Info("ListBudgets", zap.String("body", fmt.Sprintf("%v", req)))
+
+	budgets, err := s.budgetCtrl.ListBudgets(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	return &pb.ListBudgetsResponse{
+		Budgets: budgets,
+	}, nil
+}
+
+func (s *masterServiceImpl) GetBudgetStatus(ctx context.Context, req *pb.GetBudgetStatusRequest) (*pb.GetBudgetStatusResponse, error) {
+	s.logger.Info("GetBudgetStatus", zap.String("body", fmt.Sprintf("%v", req)))
+
+	statuses, err := s.budgetCtrl.GetBudgetStatus(ctx, req.UserId, optionalTime(req.Date))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budget status: %w", err)
+	}
+
+	return &pb.GetBudgetStatusResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+func (s *masterServiceImpl) CreateGoal(ctx context.Context, req *pb.CreateGoalRequest) (*pb.CreateGoalResponse, error) {
+	s.logger.Info("CreateGoal", zap.String("body", fmt.Sprintf("%v", req)))
+
+	created, err := s.goalCtrl.CreateGoal(
+		ctx,
+		req.UserId,
+		req.Name,
+		req.GetTarget().GetAmount(),
+		req.GetTarget().GetCurrency(),
+		optionalTime(req.Deadline),
+		req.AccountId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create goal: %w", err)
+	}
+
+	return &pb.CreateGoalResponse{
+		Goal: created,
+	}, nil
+}
+
+func (s *masterServiceImpl) UpdateGoal(ctx context.Context, req *pb.UpdateGoalRequest) (*pb.UpdateGoalResponse, error) {
+	s.logger.Info("UpdateGoal", zap.String("body", fmt.Sprintf("%v", req)))
+
+	updated, err := s.goalCtrl.UpdateGoal(
+		ctx,
+		req.UserId,
+		req.GoalId,
+		req.Name,
+		req.GetTarget().GetAmount(),
+		req.GetTarget().GetCurrency(),
+		optionalTime(req.Deadline),
+		req.AccountId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	return &pb.UpdateGoalResponse{
+		Goal: updated,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteGoal(ctx context.Context, req *pb.DeleteGoalRequest) (*pb.DeleteGoalResponse, error) {
+	s.logger.Info("DeleteGoal", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.goalCtrl.DeleteGoal(ctx, req.UserId, req.GoalId); err != nil {
+		return nil, fmt.Errorf("failed to delete goal: %w", err)
+	}
+
+	return &pb.DeleteGoalResponse{}, nil
+}
+
+func (s *masterServiceImpl) GetGoals(ctx context.Context, req *pb.GetGoalsRequest) (*pb.GetGoalsResponse, error) {
+	s.logger.Info("GetGoals", zap.String("body", fmt.Sprintf("%v", req)))
+
+	goals, err := s.goalCtrl.GetGoals(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+
+	return &pb.GetGoalsResponse{
+		Goals: goals,
+	}, nil
+}
+
+func (s *masterServiceImpl) AddGoalContribution(ctx context.Context, req *pb.AddGoalContributionRequest) (*pb.AddGoalContributionResponse, error) {
+	s.logger.Info("AddGoalContribution", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.goalCtrl.AddContribution(ctx, req.UserId, req.GoalId, req.TransactionId); err != nil {
+		return nil, fmt.Errorf("failed to add goal contribution: %w", err)
+	}
+
+	return &pb.AddGoalContributionResponse{}, nil
+}
+
+func (s *masterServiceImpl) RemoveGoalContribution(ctx context.Context, req *pb.RemoveGoalContributionRequest) (*pb.RemoveGoalContributionResponse, error) {
+	s.logger.Info("RemoveGoalContribution", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.goalCtrl.RemoveContribution(ctx, req.UserId, req.GoalId, req.TransactionId); err != nil {
+		return nil, fmt.Errorf("failed to remove goal contribution: %w", err)
+	}
+
+	return &pb.RemoveGoalContributionResponse{}, nil
+}
+
+func (s *masterServiceImpl) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	s.logger.Info("ListCategories", zap.String("body", fmt.Sprintf("%v", req)))
+
+	categories, err := s.categoryCtrl.ListCategories(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	return &pb.ListCategoriesResponse{
+		Categories: categories,
+	}, nil
+}
+
+func (s *masterServiceImpl) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	s.logger.Info("CreateCategory", zap.String("body", fmt.Sprintf("%v", req)))
+
+	created, err := s.categoryCtrl.CreateCategory(
+		ctx,
+		req.UserId,
+		req.Name,
+		req.Icon,
+		req.Color,
+		req.ParentId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return &pb.CreateCategoryResponse{
+		Category: created,
+	}, nil
+}
+
+func (s *masterServiceImpl) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	s.logger.Info("UpdateCategory", zap.String("body", fmt.Sprintf("%v", req)))
+
+	updated, err := s.categoryCtrl.UpdateCategory(
+		ctx,
+		req.UserId,
+		req.CategoryId,
+		req.Name,
+		req.Icon,
+		req.Color,
+		req.ParentId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return &pb.UpdateCategoryResponse{
+		Category: updated,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	s.logger.Info("DeleteCategory", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.categoryCtrl.DeleteCategory(ctx, req.UserId, req.CategoryId); err != nil {
+		return nil, fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return &pb.DeleteCategoryResponse{}, nil
+}
+
+func (s *masterServiceImpl) ListRules(ctx context.Context, req *pb.ListRulesRequest) (*pb.ListRulesResponse, error) {
+	s.logger.Info("ListRules", zap.String("body", fmt.Sprintf("%v", req)))
+
+	rules, err := s.ruleCtrl.ListRules(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules: %w", err)
+	}
+
+	return &pb.ListRulesResponse{
+		Rules: rules,
+	}, nil
+}
+
+func (s *masterServiceImpl) CreateRule(ctx context.Context, req *pb.CreateRuleRequest) (*pb.CreateRuleResponse, error) {
+	s.logger.Info("CreateRule", zap.String("body", fmt.Sprintf("%v", req)))
+
+	created, err := s.ruleCtrl.CreateRule(ctx, req.UserId, req.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rule: %w", err)
+	}
+
+	return &pb.CreateRuleResponse{
+		Rule: created,
+	}, nil
+}
+
+func (s *masterServiceImpl) UpdateRule(ctx context.Context, req *pb.UpdateRuleRequest) (*pb.UpdateRuleResponse, error) {
+	s.logger.Info("UpdateRule", zap.String("body", fmt.Sprintf("%v", req)))
+
+	updated, err := s.ruleCtrl.UpdateRule(ctx, req.UserId, req.RuleId, req.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update rule: %w", err)
+	}
+
+	return &pb.UpdateRuleResponse{
+		Rule: updated,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*pb.DeleteRuleResponse, error) {
+	s.logger.Info("DeleteRule", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.ruleCtrl.DeleteRule(ctx, req.UserId, req.RuleId); err != nil {
+		return nil, fmt.Errorf("failed to delete rule: %w", err)
+	}
+
+	return &pb.DeleteRuleResponse{}, nil
+}
+
+func (s *masterServiceImpl) ReorderRules(ctx context.Context, req *pb.ReorderRulesRequest) (*pb.ReorderRulesResponse, error) {
+	s.logger.Info("ReorderRules", zap.String("body", fmt.Sprintf("%v", req)))
+
+	rules, err := s.ruleCtrl.ReorderRules(ctx, req.UserId, req.RuleIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reorder rules: %w", err)
+	}
+
+	return &pb.ReorderRulesResponse{
+		Rules: rules,
+	}, nil
+}
+
+func (s *masterServiceImpl) DryRunRule(ctx context.Context, req *pb.DryRunRuleRequest) (*pb.DryRunRuleResponse, error) {
+	s.logger.Info("DryRunRule", zap.String("body", fmt.Sprintf("%v", req)))
+
+	result, err := s.ruleCtrl.DryRunRule(ctx, req.UserId, req.Rule, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dry run rule: %w", err)
+	}
+
+	return &pb.DryRunRuleResponse{
+		Changes:   result.Changes,
+		Truncated: result.Truncated,
+	}, nil
+}
+
+func (s *masterServiceImpl) ApplyRules(ctx context.Context, req *pb.ApplyRulesRequest) (*pb.ApplyRulesResponse, error) {
+	s.logger.Info("ApplyRules", zap.String("body", fmt.Sprintf("%v", req)))
+
+	updated, err := s.ruleCtrl.ApplyRules(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply rules: %w", err)
+	}
+
+	return &pb.ApplyRulesResponse{
+		UpdatedCount: updated,
+	}, nil
+}
+
+func (s *masterServiceImpl) ListImportProfiles(ctx context.Context, req *pb.ListImportProfilesRequest) (*pb.ListImportProfilesResponse, error) {
+	s.logger.Info("ListImportProfiles", zap.String("body", fmt.Sprintf("%v", req)))
+
+	profiles, err := s.importCtrl.ListProfiles(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import profiles: %w", err)
+	}
+
+	return &pb.ListImportProfilesResponse{
+		Profiles: profiles,
+	}, nil
+}
+
+func (s *masterServiceImpl) CreateImportProfile(ctx context.Context, req *pb.CreateImportProfileRequest) (*pb.CreateImportProfileResponse, error) {
+	s.logger.Info("CreateImportProfile", zap.String("body", fmt.Sprintf("%v", req)))
+
+	profile, err := s.importCtrl.CreateProfile(ctx, req.UserId, req.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import profile: %w", err)
+	}
+
+	return &pb.CreateImportProfileResponse{
+		Profile: profile,
+	}, nil
+}
+
+func (s *masterServiceImpl) UpdateImportProfile(ctx context.Context, req *pb.UpdateImportProfileRequest) (*pb.UpdateImportProfileResponse, error) {
+	s.logger.Info("UpdateImportProfile", zap.String("body", fmt.Sprintf("%v", req)))
+
+	profile, err := s.importCtrl.UpdateProfile(ctx, req.UserId, req.ProfileId, req.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update import profile: %w", err)
+	}
+
+	return &pb.UpdateImportProfileResponse{
+		Profile: profile,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteImportProfile(ctx context.Context, req *pb.DeleteImportProfileRequest) (*pb.DeleteImportProfileResponse, error) {
+	s.logger.Info("DeleteImportProfile", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.importCtrl.DeleteProfile(ctx, req.UserId, req.ProfileId); err != nil {
+		return nil, fmt.Errorf("failed to delete import profile: %w", err)
+	}
+
+	return &pb.DeleteImportProfileResponse{}, nil
+}
+
+func (s *masterServiceImpl) ImportTransactions(ctx context.Context, req *pb.ImportTransactionsRequest) (*pb.ImportTransactionsResponse, error) {
+	// the statement itself is left out of the log
+	s.logger.Info(
+		"ImportTransactions",
+		zap.String("user_id", req.UserId),
+		zap.String("account_id", req.AccountId),
+		zap.String("format", req.Format.String()),
+		zap.String("profile_id", req.ProfileId),
+		zap.Int("size", len(req.Content)),
+		zap.Bool("dry_run", req.DryRun),
+	)
+
+	result, err := s.importCtrl.ImportTransactions(
+		ctx,
+		req.UserId,
+		req.AccountId,
+		req.TransferAccountId,
+		req.Format,
+		req.ProfileId,
+		req.Profile,
+		req.Content,
+		req.DryRun,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import transactions: %w", err)
+	}
+
+	return &pb.ImportTransactionsResponse{
+		Rows:          result.Rows,
+		AcceptedCount: result.Accepted,
+		SkippedCount:  result.Skipped,
+		ErrorCount:    result.Errors,
+	}, nil
+}
+
+func (s *masterServiceImpl) ListRecurringTransactions(ctx context.Context, req *pb.ListRecurringTransactionsRequest) (*pb.ListRecurringTransactionsResponse, error) {
+	s.logger.Info("ListRecurringTransactions", zap.String("body", fmt.Sprintf("%v", req)))
+
+	templates, err := s.recurCtrl.ListRecurring(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring transactions: %w", err)
+	}
+
+	return &pb.ListRecurringTransactionsResponse{
+		Recurring: templates,
+	}, nil
+}
+
+func (s *masterServiceImpl) CreateRecurringTransaction(ctx context.Context, req *pb.CreateRecurringTransactionRequest) (*pb.CreateRecurringTransactionResponse, error) {
+	s.logger.Info("CreateRecurringTransaction", zap.String("body", fmt.Sprintf("%v", req)))
+
+	template, err := s.recurCtrl.CreateRecurring(ctx, req.UserId, req.Recurring)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recurring transaction: %w", err)
+	}
+
+	return &pb.CreateRecurringTransactionResponse{
+		Recurring: template,
+	}, nil
+}
+
+func (s *masterServiceImpl) PauseRecurringTransaction(ctx context.Context, req *pb.PauseRecurringTransactionRequest) (*pb.PauseRecurringTransactionResponse, error) {
+	s.logger.Info("PauseRecurringTransaction", zap.String("body", fmt.Sprintf("%v", req)))
+
+	template, err := s.recurCtrl.SetPaused(ctx, req.UserId, req.RecurringId, req.Paused)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause recurring transaction: %w", err)
+	}
+
+	return &pb.PauseRecurringTransactionResponse{
+		Recurring: template,
+	}, nil
+}
+
+func (s *masterServiceImpl) SkipRecurringTransaction(ctx context.Context, req *pb.SkipRecurringTransactionRequest) (*pb.SkipRecurringTransactionResponse, error) {
+	s.logger.Info("SkipRecurringTransaction", zap.String("body", fmt.Sprintf("%v", req)))
+
+	template, err := s.recurCtrl.SkipNext(ctx, req.UserId, req.RecurringId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skip recurring transaction: %w", err)
+	}
+
+	return &pb.SkipRecurringTransactionResponse{
+		Recurring: template,
+	}, nil
+}
+
+func (s *masterServiceImpl) DeleteRecurringTransaction(ctx context.Context, req *pb.DeleteRecurringTransactionRequest) (*pb.DeleteRecurringTransactionResponse, error) {
+	s.logger.Info("DeleteRecurringTransaction", zap.String("body", fmt.Sprintf("%v", req)))
+
+	if err := s.recurCtrl.DeleteRecurring(ctx, req.UserId, req.RecurringId); err != nil {
+		return nil, fmt.Errorf("failed to delete recurring transaction: %w", err)
+	}
+
+	return &pb.DeleteRecurringTransactionResponse{}, nil
+}
+
+func (s *masterServiceImpl) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	s.logger.Info("ListTags", zap.String("body", fmt.Sprintf("%v", req)))
+
+	tags, err := s.tagCtrl.ListTags(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return &pb.ListTagsResponse{
+		Tags: tags,
+	}, nil
+}
+
+func (s *masterServiceImpl) AddTransactionTags(ctx context.Context, req *pb.AddTransactionTagsRequest) (*pb.AddTransactionTagsResponse, error) {
+	s.logger.Info("AddTransactionTags", zap.String("body", fmt.Sprintf("%v", req)))
+
+	tags, err := s.tagCtrl.AddTags(ctx, req.UserId, req.TransactionId, req.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add transaction tags: %w", err)
+	}
+
+	return &pb.AddTransactionTagsResponse{
+		Tags: tags,
+	}, nil
+}
+
+func (s *masterServiceImpl) RemoveTransactionTags(ctx context.Context, req *pb.RemoveTransactionTagsRequest) (*pb.RemoveTransactionTagsResponse, error) {
+	s.logger.Info("RemoveTransactionTags", zap.String("body", fmt.Sprintf("%v", req)))
+
+	tags, err := s.tagCtrl.RemoveTags(ctx, req.UserId, req.TransactionId, req.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove transaction tags: %w", err)
+	}
+
+	return &pb.RemoveTransactionTagsResponse{
+		Tags: tags,
+	}, nil
+}
+
+func (s *masterServiceImpl) GetTagSummary(ctx context.Context, req *pb.GetTagSummaryRequest) (*pb.GetTagSummaryResponse, error) {
+	s.logger.Info("GetTagSummary", zap.String("body", fmt.Sprintf("%v", req)))
+
+	summaries, err := s.tagCtrl.GetTagSummary(
+		ctx,
+		req.UserId,
+		optionalTime(req.StartDate),
+		optionalTime(req.EndDate),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag summary: %w", err)
+	}
+
+	return &pb.GetTagSummaryResponse{
+		Summaries: summaries,
+	}, nil
+}
+
+func (s *masterServiceImpl) GetBalanceHistory(ctx context.Context, req *pb.GetBalanceHistoryRequest) (*pb.GetBalanceHistoryResponse, error) {
+	s.logger.Info("GetBalanceHistory", zap.String("body", fmt.Sprintf("%v", req)))
+
+	history, err := s.balanceCtrl.GetBalanceHistory(
+		ctx,
+		req.UserId,
+		req.Interval,
+		optionalTime(req.StartDate),
+		optionalTime(req.EndDate),
+		req.Currency,
+	)
+	if err != nil {
+		return nil, balanceError(fmt.Errorf("failed to get balance history: %w", err))
+	}
+
+	return history, nil
+}
+
+func (s *masterServiceImpl) GetBalanceAt(ctx context.Context, req *pb.GetBalanceAtRequest) (*pb.GetBalanceAtResponse, error) {
+	s.logger.Info("GetBalanceAt", zap.String("body", fmt.Sprintf("%v", req)))
+
+	balance, err := s.balanceCtrl.GetBalanceAt(ctx, req.UserId, optionalTime(req.Date), req.Currency)
+	if err != nil {
+		return nil, balanceError(fmt.Errorf("failed to get balance: %w", err))
+	}
+
+	return balance, nil
+}
+
+// analyzerError reports the analyzer requests rejected before reaching the
+// analyzer, such as DAY or WEEK grouping, as invalid arguments.
+func analyzerError(err error) error {
+	switch {
+	case errors.Is(err, anal.ErrInvalidWindow),
+		errors.Is(err, anal.ErrTooManyBuckets),
+		errors.Is(err, anal.ErrUnsupportedPeriod):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+// balanceError reports a malformed history window, interval or currency as
+// an invalid argument.
+func balanceError(err error) error {
+	switch {
+	case errors.Is(err, balance.ErrInvalidWindow),
+		errors.Is(err, balance.ErrTooManyPoints),
+		errors.Is(err, balance.ErrUnsupportedPeriod),
+		errors.Is(err, currency.ErrInvalidCurrency):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+// optionalTime converts an unset timestamp to the zero time instead of the
+// Unix epoch returned by AsTime.
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -5,10 +5,35 @@ import (
 	pb "backend-master/internal/api-gen/proto/master"
 	"backend-master/internal/data/database"
 	analRepo "backend-master/internal/data/repositories/analyzer"
+	attachmentRepo "backend-master/internal/data/repositories/attachment"
+	budgetRepo "backend-master/internal/data/repositories/budget"
+	categoryRepo "backend-master/internal/data/repositories/category"
+	currencyRepo "backend-master/internal/data/repositories/currency"
+	goalRepo "backend-master/internal/data/repositories/goal"
+	importRepo "backend-master/internal/data/repositories/imports"
 	marketRepo "backend-master/internal/data/repositories/market"
+	notificationRepo "backend-master/internal/data/repositories/notification"
+	recurringRepo "backend-master/internal/data/repositories/recurring"
+	ruleRepo "backend-master/internal/data/repositories/rule"
+	tagRepo "backend-master/internal/data/repositories/tag"
 	walletRepo "backend-master/internal/data/repositories/wallet"
+	"backend-master/internal/data/secrets"
+	"backend-master/internal/data/storage"
 	analyzerController "backend-master/internal/domain/controllers/analyzer"
+	attachmentController "backend-master/internal/domain/controllers/attachment"
+	balanceController "backend-master/internal/domain/controllers/balance"
+	budgetController "backend-master/internal/domain/controllers/budget"
+	categoryController "backend-master/internal/domain/controllers/category"
+	currencyController "backend-master/internal/domain/controllers/currency"
+	exportController "backend-master/internal/domain/controllers/export"
+	goalController "backend-master/internal/domain/controllers/goal"
+	importController "backend-master/internal/domain/controllers/imports"
 	marketController "backend-master/internal/domain/controllers/market"
+	netWorthController "backend-master/internal/domain/controllers/networth"
+	notificationController "backend-master/internal/domain/controllers/notification"
+	recurringController "backend-master/internal/domain/controllers/recurring"
+	ruleController "backend-master/internal/domain/controllers/rule"
+	tagController "backend-master/internal/domain/controllers/tag"
 	walletController "backend-master/internal/domain/controllers/wallet"
 	"backend-master/internal/presentation"
 	"backend-master/internal/presentation/docs"
@@ -40,6 +65,10 @@ type serviceImpl struct {
 	cfg        *configs.ServiceConfig
 	grpcServer *grpc.Server
 	ginEngine  *gin.Engine
+	dispatcher *notificationController.Dispatcher
+	scheduler  *recurringController.Scheduler
+	exportCtrl exportController.ExportController
+	attachCtrl attachmentController.AttachmentController
 	logger     *zap.Logger
 }
 
@@ -55,6 +84,41 @@ func NewService(
 	}
 
 	walletRepository := walletRepo.NewRepository(dbManager, logger)
+	currencyRepository := currencyRepo.NewRepository(dbManager, logger)
+
+	brokerTokenCipher := secrets.NoKeyCipher
+	if cfg.SecretsCfg.BrokerTokenKey != "" {
+		brokerTokenCipher, err = secrets.NewAESCipher(cfg.SecretsCfg.BrokerTokenKey)
+		if err != nil {
+			logger.Fatal("failed to initialize broker token cipher", zap.Error(err))
+		}
+	} else {
+		logger.Warn("BROKER_TOKEN_KEY is not set, broker linking is disabled")
+	}
+	marketRepository := marketRepo.NewRepository(dbManager, brokerTokenCipher, logger)
+	notificationRepository := notificationRepo.NewRepository(dbManager, logger)
+	budgetRepository := budgetRepo.NewRepository(dbManager, logger)
+	goalRepository := goalRepo.NewRepository(dbManager, logger)
+	categoryRepository := categoryRepo.NewRepository(dbManager, logger)
+	ruleRepository := ruleRepo.NewRepository(dbManager, logger)
+	importRepository := importRepo.NewRepository(dbManager, logger)
+	recurringRepository := recurringRepo.NewRepository(dbManager, logger)
+	tagRepository := tagRepo.NewRepository(dbManager, logger)
+	attachmentRepository := attachmentRepo.NewRepository(dbManager, logger)
+
+	blobStorage, err := storage.New(cfg.StorageCfg)
+	if err != nil {
+		logger.Fatal("failed to initialize blob storage", zap.Error(err))
+	}
+
+	rateProviders := []currencyRepo.RateProvider{currencyRepository}
+	if cfg.CurrencyCfg.RatesFile != "" {
+		fileProvider, err := currencyRepo.NewFileProvider(cfg.CurrencyCfg.RatesFile)
+		if err != nil {
+			logger.Fatal("failed to load exchange rates file", zap.Error(err))
+		}
+		rateProviders = append(rateProviders, fileProvider)
+	}
 
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -88,9 +152,100 @@ func NewService(
 		logger.Fatal("failed to initialize analyzer client", zap.Error(err))
 	}
 
-	walletCtrl := walletController.NewController(walletRepository, walletClient, logger)
-	marketCtrl := marketController.NewController(marketClient, logger)
+	notificationClient, err := notificationRepo.NewClient(
+		cfg.SlavesCfg.NotificationUrl,
+		logger,
+		opts...,
+	)
+	if err != nil {
+		logger.Fatal("failed to initialize notification client", zap.Error(err))
+	}
+
+	notificationCtrl := notificationController.NewController(
+		notificationRepository,
+		logger,
+	)
+	categoryCtrl := categoryController.NewController(categoryRepository, logger)
+	budgetCtrl := budgetController.NewController(
+		budgetRepository,
+		notificationCtrl,
+		categoryCtrl,
+		logger,
+	)
+	ruleCtrl := ruleController.NewController(
+		ruleRepository,
+		walletRepository,
+		tagRepository,
+		categoryCtrl,
+		logger,
+	)
+	walletCtrl := walletController.NewController(
+		walletRepository,
+		walletClient,
+		budgetCtrl,
+		categoryCtrl,
+		ruleCtrl,
+		tagRepository,
+		blobStorage,
+		logger,
+	)
+	importCtrl := importController.NewController(
+		importRepository,
+		walletRepository,
+		walletCtrl,
+		logger,
+	)
+	exportCtrl := exportController.NewController(walletRepository, logger)
+	recurringCtrl := recurringController.NewController(
+		recurringRepository,
+		walletRepository,
+		categoryCtrl,
+		logger,
+	)
+	recurringScheduler := recurringController.NewScheduler(
+		recurringRepository,
+		walletCtrl,
+		cfg.RecurringCfg,
+		logger,
+	)
+	tagCtrl := tagController.NewController(tagRepository, logger)
+	attachmentCtrl := attachmentController.NewController(
+		attachmentRepository,
+		blobStorage,
+		cfg.StorageCfg,
+		logger,
+	)
+	marketCtrl := marketController.NewController(
+		marketRepository,
+		walletRepository,
+		marketClient,
+		logger,
+	)
 	analyzerCtrl := analyzerController.NewController(analyzerClient, logger)
+	currencyCtrl := currencyController.NewController(
+		currencyRepo.NewChainProvider(rateProviders...),
+		logger,
+	)
+	notificationDispatcher := notificationController.NewDispatcher(
+		notificationRepository,
+		notificationClient,
+		cfg.OutboxCfg,
+		logger,
+	)
+	goalCtrl := goalController.NewController(
+		goalRepository,
+		walletCtrl,
+		analyzerCtrl,
+		currencyCtrl,
+		logger,
+	)
+	balanceCtrl := balanceController.NewController(walletRepository, currencyCtrl, logger)
+	netWorthCtrl := netWorthController.NewController(
+		walletCtrl,
+		marketCtrl,
+		currencyCtrl,
+		logger,
+	)
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(presentation.UnaryServerInterceptor(logger)),
@@ -100,6 +255,17 @@ func NewService(
 		walletCtrl,
 		marketCtrl,
 		analyzerCtrl,
+		currencyCtrl,
+		netWorthCtrl,
+		notificationCtrl,
+		budgetCtrl,
+		goalCtrl,
+		categoryCtrl,
+		ruleCtrl,
+		importCtrl,
+		recurringCtrl,
+		tagCtrl,
+		balanceCtrl,
 	)
 	pb.RegisterMasterServiceServer(grpcServer, masterService)
 
@@ -107,6 +273,10 @@ func NewService(
 		cfg:        cfg,
 		grpcServer: grpcServer,
 		ginEngine:  gin.New(),
+		dispatcher: notificationDispatcher,
+		scheduler:  recurringScheduler,
+		exportCtrl: exportCtrl,
+		attachCtrl: attachmentCtrl,
 		logger:     logger,
 	}
 
@@ -144,6 +314,9 @@ func (s *serviceImpl) Start() error {
 		}
 	}()
 
+	s.dispatcher.Start()
+	s.scheduler.Start()
+
 	grpcMux := runtime.NewServeMux()
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -176,6 +349,14 @@ func (s *serviceImpl) Start() error {
 
 	apiRouter := s.ginEngine.Group("/api")
 	apiRouter.GET("/docs", docs.NewSwaggerHandler(swaggerJSON))
+	// outside of /v1, whose catch-all route belongs to the gateway
+	apiRouter.GET("/exports/transactions", presentation.NewExportHandler(s.exportCtrl, s.logger))
+	apiRouter.POST(
+		"/transactions/:transaction_id/attachments",
+		presentation.NewUploadAttachmentHandler(s.attachCtrl, s.logger),
+	)
+	apiRouter.GET("/attachments/:attachment_id", presentation.NewDownloadAttachmentHandler(s.attachCtrl, s.logger))
+	apiRouter.DELETE("/attachments/:attachment_id", presentation.NewDeleteAttachmentHandler(s.attachCtrl, s.logger))
 
 	apiV1Router := apiRouter.Group("/v1")
 	apiV1Router.Any(
@@ -191,5 +372,7 @@ func (s *serviceImpl) Start() error {
 func (s *serviceImpl) Shutdown() error {
 	s.logger.Info("shutting down servers")
 	s.grpcServer.GracefulStop()
+	s.dispatcher.Stop()
+	s.scheduler.Stop()
 	return nil
 }
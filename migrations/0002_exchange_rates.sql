@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    base_currency  CHAR(3)        NOT NULL,
+    quote_currency CHAR(3)        NOT NULL,
+    rate           NUMERIC(24, 12) NOT NULL CHECK (rate > 0),
+    rate_date      DATE           NOT NULL,
+    created_at     TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (base_currency, quote_currency, rate_date)
+);
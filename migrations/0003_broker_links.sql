@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS broker_links (
+    account_id          UUID        PRIMARY KEY REFERENCES accounts (id) ON DELETE CASCADE,
+    user_id             UUID        NOT NULL,
+    backend_type        TEXT        NOT NULL,
+    external_account_id TEXT        NOT NULL,
+    token_encrypted     BYTEA       NOT NULL,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
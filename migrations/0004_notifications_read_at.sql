@@ -0,0 +1,5 @@
+ALTER TABLE notifications
+    ADD COLUMN IF NOT EXISTS read_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS notifications_user_id_created_at_idx
+    ON notifications (user_id, created_at DESC, id DESC);
@@ -0,0 +1,16 @@
+-- notifications become an outbox: rows are written as PENDING together with
+-- the change that triggered them and delivered by the dispatcher afterwards.
+-- Rows that existed before were already delivered.
+ALTER TABLE notifications
+    ALTER COLUMN sent_at DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'SENT',
+    ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS last_error TEXT;
+
+ALTER TABLE notifications
+    ALTER COLUMN status SET DEFAULT 'PENDING';
+
+CREATE INDEX IF NOT EXISTS notifications_pending_next_attempt_at_idx
+    ON notifications (next_attempt_at)
+    WHERE status = 'PENDING';
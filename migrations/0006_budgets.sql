@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS budgets (
+    id           UUID        PRIMARY KEY,
+    user_id      UUID        NOT NULL,
+    mcc          INT         NOT NULL,
+    period       TEXT        NOT NULL,
+    limit_amount BIGINT      NOT NULL CHECK (limit_amount > 0),
+    currency     TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, mcc, period)
+);
+
+-- one row per threshold crossed in a budget period, so every alert is sent once
+CREATE TABLE IF NOT EXISTS budget_alerts (
+    budget_id    UUID        NOT NULL REFERENCES budgets (id) ON DELETE CASCADE,
+    period_start TIMESTAMPTZ NOT NULL,
+    threshold    INT         NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (budget_id, period_start, threshold)
+);
+
+CREATE INDEX IF NOT EXISTS transactions_mcc_created_at_idx
+    ON transactions (mcc, created_at);
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS goals (
+    id            UUID        PRIMARY KEY,
+    user_id       UUID        NOT NULL,
+    name          TEXT        NOT NULL,
+    target_amount BIGINT      NOT NULL CHECK (target_amount > 0),
+    currency      TEXT        NOT NULL,
+    deadline      TIMESTAMPTZ,
+    account_id    UUID        REFERENCES accounts (id) ON DELETE SET NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS goals_user_id_idx
+    ON goals (user_id);
+
+-- transactions tagged as contributions to goals without a linked account
+CREATE TABLE IF NOT EXISTS goal_contributions (
+    goal_id        UUID        NOT NULL REFERENCES goals (id) ON DELETE CASCADE,
+    transaction_id UUID        NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (goal_id, transaction_id)
+);
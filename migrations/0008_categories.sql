@@ -0,0 +1,115 @@
+-- categories with a NULL user_id are system categories, visible to every user
+CREATE TABLE IF NOT EXISTS categories (
+    id         UUID        PRIMARY KEY,
+    user_id    UUID,
+    parent_id  UUID        REFERENCES categories (id) ON DELETE SET NULL,
+    name       TEXT        NOT NULL,
+    icon       TEXT        NOT NULL DEFAULT '',
+    color      TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS categories_user_id_idx
+    ON categories (user_id);
+
+-- inclusive MCC ranges mapped to system categories
+CREATE TABLE IF NOT EXISTS mcc_categories (
+    mcc_from    INT  PRIMARY KEY,
+    mcc_to      INT  NOT NULL CHECK (mcc_to >= mcc_from),
+    category_id UUID NOT NULL REFERENCES categories (id) ON DELETE CASCADE
+);
+
+INSERT INTO categories (id, name, icon, color) VALUES
+    ('c0000000-0000-0000-0000-000000000001', 'Groceries', 'cart', '#4CAF50'),
+    ('c0000000-0000-0000-0000-000000000002', 'Restaurants', 'restaurant', '#FF9800'),
+    ('c0000000-0000-0000-0000-000000000003', 'Transport', 'bus', '#2196F3'),
+    ('c0000000-0000-0000-0000-000000000004', 'Taxi', 'taxi', '#FFC107'),
+    ('c0000000-0000-0000-0000-000000000005', 'Fuel', 'fuel', '#795548'),
+    ('c0000000-0000-0000-0000-000000000006', 'Health', 'health', '#F44336'),
+    ('c0000000-0000-0000-0000-000000000007', 'Clothing', 'shirt', '#9C27B0'),
+    ('c0000000-0000-0000-0000-000000000008', 'Entertainment', 'ticket', '#E91E63'),
+    ('c0000000-0000-0000-0000-000000000009', 'Utilities', 'home', '#607D8B'),
+    ('c0000000-0000-0000-0000-000000000010', 'Travel', 'plane', '#00BCD4'),
+    ('c0000000-0000-0000-0000-000000000011', 'Home', 'sofa', '#8BC34A'),
+    ('c0000000-0000-0000-0000-000000000012', 'Electronics', 'laptop', '#3F51B5'),
+    ('c0000000-0000-0000-0000-000000000013', 'Cash', 'cash', '#9E9E9E'),
+    ('c0000000-0000-0000-0000-000000000014', 'Financial services', 'bank', '#009688'),
+    ('c0000000-0000-0000-0000-000000000015', 'Education', 'book', '#673AB7'),
+    ('c0000000-0000-0000-0000-000000000016', 'Beauty', 'scissors', '#FF5722'),
+    ('c0000000-0000-0000-0000-000000000017', 'Pets', 'paw', '#CDDC39'),
+    ('c0000000-0000-0000-0000-000000000018', 'Other', 'dots', '#BDBDBD')
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO mcc_categories (mcc_from, mcc_to, category_id) VALUES
+    (742, 742, 'c0000000-0000-0000-0000-000000000017'),
+    (3000, 3999, 'c0000000-0000-0000-0000-000000000010'),
+    (4111, 4112, 'c0000000-0000-0000-0000-000000000003'),
+    (4121, 4121, 'c0000000-0000-0000-0000-000000000004'),
+    (4131, 4131, 'c0000000-0000-0000-0000-000000000003'),
+    (4411, 4411, 'c0000000-0000-0000-0000-000000000010'),
+    (4511, 4511, 'c0000000-0000-0000-0000-000000000010'),
+    (4722, 4722, 'c0000000-0000-0000-0000-000000000010'),
+    (4784, 4784, 'c0000000-0000-0000-0000-000000000003'),
+    (4789, 4789, 'c0000000-0000-0000-0000-000000000003'),
+    (4812, 4816, 'c0000000-0000-0000-0000-000000000009'),
+    (4829, 4829, 'c0000000-0000-0000-0000-000000000014'),
+    (4899, 4900, 'c0000000-0000-0000-0000-000000000009'),
+    (5122, 5122, 'c0000000-0000-0000-0000-000000000006'),
+    (5200, 5261, 'c0000000-0000-0000-0000-000000000011'),
+    (5411, 5411, 'c0000000-0000-0000-0000-000000000001'),
+    (5422, 5422, 'c0000000-0000-0000-0000-000000000001'),
+    (5441, 5441, 'c0000000-0000-0000-0000-000000000001'),
+    (5451, 5451, 'c0000000-0000-0000-0000-000000000001'),
+    (5462, 5462, 'c0000000-0000-0000-0000-000000000001'),
+    (5499, 5499, 'c0000000-0000-0000-0000-000000000001'),
+    (5541, 5542, 'c0000000-0000-0000-0000-000000000005'),
+    (5611, 5699, 'c0000000-0000-0000-0000-000000000007'),
+    (5712, 5719, 'c0000000-0000-0000-0000-000000000011'),
+    (5732, 5734, 'c0000000-0000-0000-0000-000000000012'),
+    (5811, 5814, 'c0000000-0000-0000-0000-000000000002'),
+    (5912, 5912, 'c0000000-0000-0000-0000-000000000006'),
+    (5983, 5983, 'c0000000-0000-0000-0000-000000000005'),
+    (5995, 5995, 'c0000000-0000-0000-0000-000000000017'),
+    (6010, 6011, 'c0000000-0000-0000-0000-000000000013'),
+    (6012, 6012, 'c0000000-0000-0000-0000-000000000014'),
+    (6050, 6051, 'c0000000-0000-0000-0000-000000000014'),
+    (6211, 6211, 'c0000000-0000-0000-0000-000000000014'),
+    (6300, 6300, 'c0000000-0000-0000-0000-000000000014'),
+    (7011, 7011, 'c0000000-0000-0000-0000-000000000010'),
+    (7230, 7230, 'c0000000-0000-0000-0000-000000000016'),
+    (7297, 7298, 'c0000000-0000-0000-0000-000000000016'),
+    (7523, 7523, 'c0000000-0000-0000-0000-000000000003'),
+    (7832, 7832, 'c0000000-0000-0000-0000-000000000008'),
+    (7841, 7841, 'c0000000-0000-0000-0000-000000000008'),
+    (7911, 7999, 'c0000000-0000-0000-0000-000000000008'),
+    (8011, 8099, 'c0000000-0000-0000-0000-000000000006'),
+    (8211, 8299, 'c0000000-0000-0000-0000-000000000015')
+ON CONFLICT (mcc_from) DO NOTHING;
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS transactions_category_id_idx
+    ON transactions (category_id);
+
+UPDATE transactions t
+SET category_id = m.category_id
+FROM mcc_categories m
+WHERE 1=1
+    AND t.category_id IS NULL
+    AND t.mcc BETWEEN m.mcc_from AND m.mcc_to;
+
+-- a budget is kept either for an MCC or for a category, which includes its
+-- subcategories
+ALTER TABLE budgets
+    ALTER COLUMN mcc DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories (id) ON DELETE CASCADE;
+
+ALTER TABLE budgets
+    DROP CONSTRAINT IF EXISTS budgets_mcc_or_category_check,
+    ADD CONSTRAINT budgets_mcc_or_category_check
+        CHECK ((mcc IS NULL) <> (category_id IS NULL));
+
+CREATE UNIQUE INDEX IF NOT EXISTS budgets_user_id_category_id_period_idx
+    ON budgets (user_id, category_id, period)
+    WHERE category_id IS NOT NULL;
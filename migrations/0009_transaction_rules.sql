@@ -0,0 +1,20 @@
+-- rules are evaluated in position order; a NULL condition matches anything
+CREATE TABLE IF NOT EXISTS transaction_rules (
+    id                   UUID        PRIMARY KEY,
+    user_id              UUID        NOT NULL,
+    position             INT         NOT NULL,
+    name                 TEXT        NOT NULL,
+    enabled              BOOLEAN     NOT NULL DEFAULT TRUE,
+    description_contains TEXT,
+    description_regex    TEXT,
+    min_amount           BIGINT,
+    max_amount           BIGINT,
+    account_id           UUID        REFERENCES accounts (id) ON DELETE CASCADE,
+    mcc                  INT,
+    set_category_id      UUID        REFERENCES categories (id) ON DELETE SET NULL,
+    set_description      TEXT,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS transaction_rules_user_id_position_idx
+    ON transaction_rules (user_id, position);
@@ -0,0 +1,29 @@
+-- column mappings name a header or hold a 1-based column number
+CREATE TABLE IF NOT EXISTS import_profiles (
+    id                 UUID        PRIMARY KEY,
+    user_id            UUID        NOT NULL,
+    name               TEXT        NOT NULL,
+    delimiter          TEXT        NOT NULL DEFAULT ',',
+    encoding           TEXT        NOT NULL DEFAULT 'utf-8',
+    date_format        TEXT        NOT NULL,
+    decimal_separator  TEXT        NOT NULL DEFAULT '.',
+    skip_rows          INT         NOT NULL DEFAULT 0 CHECK (skip_rows >= 0),
+    has_header         BOOLEAN     NOT NULL DEFAULT TRUE,
+    sign_convention    TEXT        NOT NULL,
+    date_column        TEXT        NOT NULL,
+    amount_column      TEXT,
+    debit_column       TEXT,
+    credit_column      TEXT,
+    description_column TEXT,
+    mcc_column         TEXT,
+    currency_column    TEXT,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, name)
+);
+
+-- identifies the statement row a transaction was imported from
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS import_fingerprint TEXT;
+
+CREATE INDEX IF NOT EXISTS transactions_account_id_import_fingerprint_idx
+    ON transactions (account_id, import_fingerprint)
+    WHERE import_fingerprint IS NOT NULL;
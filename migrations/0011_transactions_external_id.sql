@@ -0,0 +1,6 @@
+-- the bank's own ID of an imported transaction, such as an OFX FITID
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS external_id TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS transactions_account_id_external_id_idx
+    ON transactions (account_id, external_id)
+    WHERE external_id IS NOT NULL;
@@ -0,0 +1,42 @@
+-- next_occurrence is the 0-based index of the next occurrence of the
+-- schedule and next_run_at its date; next_run_at is NULL once the schedule
+-- has ended
+CREATE TABLE IF NOT EXISTS recurring_transactions (
+    id              UUID        PRIMARY KEY,
+    user_id         UUID        NOT NULL,
+    account_id      UUID        NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    to_account_id   UUID        REFERENCES accounts (id) ON DELETE CASCADE,
+    type            TEXT        NOT NULL,
+    amount          BIGINT      NOT NULL CHECK (amount > 0),
+    currency        TEXT        NOT NULL,
+    category_id     UUID        REFERENCES categories (id) ON DELETE SET NULL,
+    mcc             INT,
+    description     TEXT,
+    frequency       TEXT        NOT NULL,
+    interval        INT         NOT NULL DEFAULT 1 CHECK (interval > 0),
+    day_of_month    INT         CHECK (day_of_month BETWEEN 1 AND 31),
+    start_date      TIMESTAMPTZ NOT NULL,
+    end_date        TIMESTAMPTZ,
+    count           INT         CHECK (count > 0),
+    next_occurrence INT         NOT NULL DEFAULT 0,
+    next_run_at     TIMESTAMPTZ,
+    paused          BOOLEAN     NOT NULL DEFAULT FALSE,
+    last_error      TEXT,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS recurring_transactions_user_id_idx
+    ON recurring_transactions (user_id);
+
+CREATE INDEX IF NOT EXISTS recurring_transactions_next_run_at_idx
+    ON recurring_transactions (next_run_at)
+    WHERE NOT paused AND next_run_at IS NOT NULL;
+
+-- every occurrence of a schedule is created once
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS recurring_id UUID REFERENCES recurring_transactions (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS occurrence   INT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS transactions_recurring_id_occurrence_idx
+    ON transactions (recurring_id, occurrence)
+    WHERE recurring_id IS NOT NULL;
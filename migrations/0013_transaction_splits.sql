@@ -0,0 +1,18 @@
+-- split lines of a transaction across categories; their amounts add up to
+-- the amount of the transaction, whose balance effect is unchanged
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id             UUID        PRIMARY KEY,
+    transaction_id UUID        NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+    position       INT         NOT NULL,
+    category_id    UUID        REFERENCES categories (id) ON DELETE SET NULL,
+    mcc            INT,
+    amount         BIGINT      NOT NULL CHECK (amount > 0),
+    note           TEXT,
+    UNIQUE (transaction_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS transaction_splits_mcc_idx
+    ON transaction_splits (mcc);
+
+CREATE INDEX IF NOT EXISTS transaction_splits_category_id_idx
+    ON transaction_splits (category_id);
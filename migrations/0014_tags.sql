@@ -0,0 +1,19 @@
+-- tag names are unique per user regardless of case
+CREATE TABLE IF NOT EXISTS tags (
+    id         UUID        PRIMARY KEY,
+    user_id    UUID        NOT NULL,
+    name       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS tags_user_id_name_idx
+    ON tags (user_id, lower(name));
+
+CREATE TABLE IF NOT EXISTS transaction_tags (
+    transaction_id UUID NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+    tag_id         UUID NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (transaction_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS transaction_tags_tag_id_idx
+    ON transaction_tags (tag_id);
@@ -0,0 +1,14 @@
+-- files attached to transactions; the content is kept in blob storage under
+-- storage_key
+CREATE TABLE IF NOT EXISTS attachments (
+    id             UUID        PRIMARY KEY,
+    transaction_id UUID        NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+    file_name      TEXT        NOT NULL,
+    content_type   TEXT        NOT NULL,
+    size           BIGINT      NOT NULL CHECK (size > 0),
+    storage_key    TEXT        NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS attachments_transaction_id_idx
+    ON attachments (transaction_id);
@@ -0,0 +1,68 @@
+-- a transfer is stored as two legs linked by transfer_id: the outgoing one in
+-- the source account and the incoming one in the target account, each in the
+-- currency of its account. Both legs carry the source and the target.
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS transfer_id     UUID,
+    ADD COLUMN IF NOT EXISTS from_account_id UUID;
+
+-- the target used to be free text, values that are not account IDs are lost
+ALTER TABLE transactions
+    ALTER COLUMN to_account_id TYPE UUID
+    USING CASE
+        WHEN to_account_id ~* '^[0-9a-f]{8}-([0-9a-f]{4}-){3}[0-9a-f]{12}$' THEN to_account_id::uuid
+    END;
+
+CREATE INDEX IF NOT EXISTS transactions_transfer_id_idx
+    ON transactions (transfer_id)
+    WHERE transfer_id IS NOT NULL;
+
+-- existing single row transfers all took the money out of their account,
+-- including the ones whose target was lost above
+UPDATE transactions
+SET from_account_id = account_id
+WHERE type = 'TRANSFER'
+    AND from_account_id IS NULL;
+
+-- the ones with a target become their outgoing legs
+UPDATE transactions
+SET transfer_id = gen_random_uuid()
+WHERE type = 'TRANSFER'
+    AND transfer_id IS NULL
+    AND to_account_id IS NOT NULL;
+
+-- and get the incoming legs, with the amount their balance effect had
+INSERT INTO transactions (
+    id,
+    account_id,
+    from_account_id,
+    to_account_id,
+    transfer_id,
+    type,
+    amount,
+    currency,
+    mcc,
+    category_id,
+    description,
+    created_at
+)
+SELECT
+    gen_random_uuid(),
+    t.to_account_id,
+    t.from_account_id,
+    t.to_account_id,
+    t.transfer_id,
+    t.type,
+    t.amount,
+    a.currency,
+    t.mcc,
+    t.category_id,
+    t.description,
+    t.created_at
+FROM transactions t
+JOIN accounts a ON a.id = t.to_account_id
+WHERE t.type = 'TRANSFER'
+    AND t.transfer_id IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM transactions p
+        WHERE p.transfer_id = t.transfer_id AND p.id <> t.id
+    );
@@ -0,0 +1,4 @@
+-- a rule may also tag the transactions it matches; tags of every matching
+-- rule are added
+ALTER TABLE transaction_rules
+    ADD COLUMN IF NOT EXISTS set_tag TEXT;
@@ -0,0 +1,4 @@
+-- a goal keeps the ID of its deleted linked account, so its progress reports
+-- the account as deleted instead of silently counting contributions
+ALTER TABLE goals
+    DROP CONSTRAINT IF EXISTS goals_account_id_fkey;